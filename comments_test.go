@@ -0,0 +1,47 @@
+package jmespath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCommentSyntaxError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expression string
+		err        string
+	}{
+		{
+			expression: "people\n# filter (\n[?age >]",
+			err:        `jmespath: invalid expression "people\n# filter (\n[?age >]": unexpected token "]"`,
+		},
+		{
+			expression: "# unbalanced (\n)",
+			err:        `jmespath: invalid expression "# unbalanced (\n)": unexpected token ")"`,
+		},
+		{
+			expression: "x # fine\n^",
+			err:        `jmespath: invalid expression "x # fine\n^": unexpected rune '^'`,
+		},
+		{
+			expression: "x # [\n| # ]\n",
+			err:        `jmespath: invalid expression "x # [\n| # ]\n": unexpected end of expression`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Compile(test.expression)
+			if !errors.Is(err, ErrSyntax) {
+				t.Fatalf("expected error %v, got %v", ErrSyntax, err)
+			}
+
+			if err.Error() != test.err {
+				t.Errorf("expected error message %q, got %q", test.err, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package jmespath
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/woodsbury/decimal128"
+)
+
+func TestCompareNaN(t *testing.T) {
+	t.Parallel()
+
+	for _, nan := range []any{decimal128.NaN(), math.NaN()} {
+		data := map[string]any{
+			"n":   nan,
+			"one": int64(1),
+			"xs":  []any{int64(1), nan, int64(7)},
+			"ys":  []any{int64(1), nan},
+		}
+
+		tests := []struct {
+			expression string
+			result     any
+		}{
+			{"one > n", false},
+			{"one >= n", false},
+			{"one < n", false},
+			{"one <= n", false},
+			{"n > one", false},
+			{"n < one", false},
+			{"n <= n", false},
+			{"n >= n", false},
+			{"ys < [`1`, `2`]", false},
+			{"ys >= [`1`, `2`]", false},
+			{"length(xs[?@ < `5`])", json.Number("1")},
+			{"length(xs[?@ >= `5`])", json.Number("1")},
+		}
+
+		for _, test := range tests {
+			result, err := Search(test.expression, data)
+			if err != nil {
+				t.Errorf("unexpected error %v from expression %q with %T NaN", err, test.expression, nan)
+				continue
+			}
+
+			if !resultEqual(test.result, result) {
+				t.Errorf("incorrect result %v from expression %q with %T NaN, expected %v", result, test.expression, nan, test.result)
+			}
+		}
+	}
+}
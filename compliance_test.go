@@ -79,6 +79,12 @@ func complianceTest(t *testing.T, dir string) {
 								t.Errorf("expected error %s from expression %q in compliance test file %s", test.Error, test.Expression, name)
 							} else {
 								switch test.Error {
+								case "call-depth-exceeded":
+									if !errors.Is(err, ErrCallDepthExceeded) {
+										t.Errorf("incorrect error %v from expression %q in compliance test file %s, expected: %v", err, test.Expression, name, ErrCallDepthExceeded)
+									} else {
+										pass.Add(1)
+									}
 								case "invalid-arity":
 									if !errors.Is(err, ErrInvalidArity) {
 										t.Errorf("incorrect error %v from expression %q in compliance test file %s, expected: %v", err, test.Expression, name, ErrInvalidArity)
@@ -97,6 +103,12 @@ func complianceTest(t *testing.T, dir string) {
 									} else {
 										pass.Add(1)
 									}
+								case "not-a-number":
+									if !errors.Is(err, ErrNotANumber) {
+										t.Errorf("incorrect error %v from expression %q in compliance test file %s, expected %v", err, test.Expression, name, ErrNotANumber)
+									} else {
+										pass.Add(1)
+									}
 								case "syntax":
 									if !errors.Is(err, ErrSyntax) {
 										t.Errorf("incorrect error %v from expression %q in compliance test file %s, expected %v", err, test.Expression, name, ErrSyntax)
@@ -6,6 +6,10 @@ import (
 )
 
 var (
+	// ErrCallDepthExceeded indicates that the expression exceeded the maximum
+	// depth of nested calls to user-defined functions.
+	ErrCallDepthExceeded = errors.New("jmespath: call depth exceeded")
+
 	// ErrEvaluationFailed indicates that the evaluation of the expression
 	// failed with an error.
 	ErrEvaluationFailed = errors.New("jmespath: evaluation failed")
@@ -26,6 +30,10 @@ var (
 	// not-a-number result.
 	ErrNotANumber = errors.New("jmespath: not a number")
 
+	// ErrNotAssignable indicates that the expression does not select locations
+	// that can be modified.
+	ErrNotAssignable = errors.New("jmespath: not assignable")
+
 	// ErrSyntax indicates that the expression contains a syntax error.
 	ErrSyntax = errors.New("jmespath: syntax error")
 
@@ -38,6 +46,18 @@ var (
 	ErrUnknownFunction = errors.New("jmespath: unknown function")
 )
 
+type callDepthExceededError struct {
+	msg string
+}
+
+func (err *callDepthExceededError) Error() string {
+	return "jmespath: " + err.msg
+}
+
+func (err *callDepthExceededError) Is(target error) bool {
+	return target == ErrCallDepthExceeded
+}
+
 type evaluationFailedError struct {
 	msg string
 }
@@ -129,6 +149,18 @@ func (err *notANumberError) Is(target error) bool {
 	return target == ErrNotANumber
 }
 
+type notAssignableError struct {
+	msg string
+}
+
+func (err *notAssignableError) Error() string {
+	return "jmespath: " + err.msg
+}
+
+func (err *notAssignableError) Is(target error) bool {
+	return target == ErrNotAssignable
+}
+
 type undefinedVariableError struct {
 	variable string
 }
@@ -2,6 +2,7 @@ package jmespath_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/woodsbury/decimal128"
 	"github.com/woodsbury/jmespath"
@@ -29,3 +30,79 @@ func ExampleCompile() {
 	// Output:
 	// 3
 }
+
+func ExampleWithClock() {
+	clock := func() time.Time {
+		return time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+	}
+
+	value := map[string]any{
+		"Created": "2024-03-01T09:30:00Z",
+	}
+
+	result, _ := jmespath.Search("time_diff(now(), Created) > `604800`", value, jmespath.WithClock(clock))
+	fmt.Println(result)
+	// Output:
+	// true
+}
+
+func ExampleExpression_Delete() {
+	value := map[string]any{
+		"Users": []any{
+			map[string]any{"Name": "ann", "Password": "secret"},
+			map[string]any{"Name": "bob", "Password": "hunter2"},
+		},
+	}
+
+	expression := jmespath.MustCompile("Users[*].Password")
+	result, _ := expression.Delete(value)
+	fmt.Println(result)
+	// Output:
+	// map[Users:[map[Name:ann] map[Name:bob]]]
+}
+
+func ExampleExpression_SearchPaths() {
+	value := map[string]any{
+		"Items": []any{
+			map[string]any{"Name": "a", "Price": decimal128.FromUint32(1)},
+			map[string]any{"Name": "b", "Price": decimal128.FromUint32(2)},
+		},
+	}
+
+	expression := jmespath.MustCompile("Items[?Price > `1`].Name")
+	matches, _ := expression.SearchPaths(value)
+	for _, match := range matches {
+		fmt.Println(match.Value, match.Pointer, match.Path)
+	}
+	// Output:
+	// b /Items/1/Name Items[1].Name
+}
+
+func ExampleExpression_Set() {
+	value := map[string]any{
+		"Items": []any{
+			map[string]any{"Type": "a", "Price": decimal128.FromUint32(1)},
+			map[string]any{"Type": "b", "Price": decimal128.FromUint32(2)},
+		},
+	}
+
+	expression := jmespath.MustCompile("Items[?Type == 'a'].Price")
+	result, _ := expression.Set(value, decimal128.FromUint32(0))
+	fmt.Println(result)
+	// Output:
+	// map[Items:[map[Price:0 Type:a] map[Price:2 Type:b]]]
+}
+
+func ExampleExpression_Update() {
+	value := map[string]any{
+		"Counts": []any{decimal128.FromUint32(1), decimal128.FromUint32(2)},
+	}
+
+	expression := jmespath.MustCompile("Counts[*]")
+	result, _ := expression.Update(value, func(old any) any {
+		return old.(decimal128.Decimal).Mul(decimal128.FromUint32(10))
+	})
+	fmt.Println(result)
+	// Output:
+	// map[Counts:[10 20]]
+}
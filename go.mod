@@ -2,4 +2,8 @@ module github.com/woodsbury/jmespath
 
 go 1.24.0
 
-require github.com/woodsbury/decimal128 v1.4.0
+require (
+	github.com/rivo/uniseg v0.4.7
+	github.com/woodsbury/decimal128 v1.4.0
+	golang.org/x/text v0.31.0
+)
@@ -10,6 +10,54 @@ import (
 	"github.com/woodsbury/jmespath/internal/parser"
 )
 
+const MaxArrayLength = 1 << 24
+
+func (e *evaluator) all(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	for _, v := range a {
+		p, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isTrue(p) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (e *evaluator) any(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	for _, v := range a {
+		p, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(p) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (e *evaluator) arrayMaxBy(value any, node parser.Node, variables *variableScope) (any, error) {
 	a, ok := value.([]any)
 	if !ok {
@@ -160,6 +208,30 @@ func (e *evaluator) arrayMinBy(value any, node parser.Node, variables *variableS
 	return a[index], nil
 }
 
+func (e *evaluator) count(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	var n int64
+	for _, v := range a {
+		p, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(p) {
+			n++
+		}
+	}
+
+	return n, nil
+}
+
 func (e *evaluator) filter(value any, node parser.Node, variables *variableScope) (any, error) {
 	a, ok := value.([]any)
 	if !ok {
@@ -215,6 +287,76 @@ func (e *evaluator) filterAndProjectArray(value any, filter parser.Node, node pa
 	return r, nil
 }
 
+func (e *evaluator) filterArray(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	r := make([]any, 0, len(a))
+	for _, v := range a {
+		p, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(p) {
+			r = append(r, v)
+		}
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) find(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	for _, v := range a {
+		p, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(p) {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (e *evaluator) findIndex(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	for i, v := range a {
+		p, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(p) {
+			return int64(i), nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (e *evaluator) flattenAndProjectArray(value any, node parser.Node, variables *variableScope) (any, error) {
 	a, ok := value.([]any)
 	if !ok {
@@ -301,6 +443,33 @@ func (e *evaluator) projectArray(value any, node parser.Node, variables *variabl
 	return r, nil
 }
 
+func (e *evaluator) reduce(value any, node parser.Node, initial any, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	scope := variables.new(make(map[string]any, 3))
+	acc := initial
+	for i, v := range a {
+		scope.variables["$acc"] = acc
+		scope.variables["$index"] = int64(i)
+		scope.variables["$item"] = v
+
+		r, err := e.evaluate(node, v, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		acc = r
+	}
+
+	return acc, nil
+}
+
 type sortByNumber struct {
 	items []any
 	by    []decimal128.Decimal
@@ -337,6 +506,37 @@ func (s sortByString) Swap(i, j int) {
 	s.by[i], s.by[j] = s.by[j], s.by[i]
 }
 
+func (e *evaluator) selectArrayEntries(value any, entries []parser.SelectArrayEntry, variables *variableScope) (any, error) {
+	r := make([]any, 0, len(entries))
+	for _, entry := range entries {
+		result, err := e.evaluate(entry.Field, value, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if !entry.Spread {
+			r = append(r, result)
+			continue
+		}
+
+		if result == nil {
+			continue
+		}
+
+		a, ok := result.([]any)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(result),
+				want: "array",
+			}
+		}
+
+		r = append(r, a...)
+	}
+
+	return r, nil
+}
+
 func (e *evaluator) sortArrayBy(value any, node parser.Node, variables *variableScope) (any, error) {
 	a, ok := value.([]any)
 	if !ok {
@@ -422,6 +622,18 @@ func (e *evaluator) sortArrayBy(value any, node parser.Node, variables *variable
 	return r.items, nil
 }
 
+func appendFlattened(r, a []any, depth int) []any {
+	for _, v := range a {
+		if va, ok := v.([]any); ok && depth != 0 {
+			r = appendFlattened(r, va, depth-1)
+		} else {
+			r = append(r, v)
+		}
+	}
+
+	return r
+}
+
 func arrayMax(v any) (any, error) {
 	a, ok := v.([]any)
 	if !ok {
@@ -534,6 +746,88 @@ func arrayMin(v any) (any, error) {
 	return min, nil
 }
 
+func chunk(value, size any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	n, err := toSize(size, "size")
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]any, 0, (len(a)+n-1)/n)
+	for len(a) > n {
+		r = append(r, a[:n:n])
+		a = a[n:]
+	}
+
+	if len(a) > 0 {
+		r = append(r, a)
+	}
+
+	return r, nil
+}
+
+func compact(value any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	r := make([]any, 0, len(a))
+	for _, v := range a {
+		switch v := v.(type) {
+		case nil:
+			continue
+		case []any:
+			if len(v) == 0 {
+				continue
+			}
+		case map[string]any:
+			if len(v) == 0 {
+				continue
+			}
+		case string:
+			if v == "" {
+				continue
+			}
+		}
+
+		r = append(r, v)
+	}
+
+	return r, nil
+}
+
+func drop(value, count any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	n, err := toInteger(count)
+	if err != nil {
+		return nil, err
+	}
+
+	if n >= 0 {
+		return a[min(n, len(a)):], nil
+	}
+
+	return a[:max(len(a)+n, 0)], nil
+}
+
 func flatten(v any) any {
 	a, ok := v.([]any)
 	if !ok {
@@ -565,6 +859,37 @@ func flatten(v any) any {
 	return r
 }
 
+func flattenDeep(value any) (any, error) {
+	return flattenDepth(value, -1)
+}
+
+func flattenDeepDepth(value, depth any) (any, error) {
+	d, err := toInteger(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	if d < 0 {
+		return nil, &negativeIntegerError{
+			i: d,
+		}
+	}
+
+	return flattenDepth(value, d)
+}
+
+func flattenDepth(value any, depth int) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	return appendFlattened(make([]any, 0, len(a)), a, depth), nil
+}
+
 func index(v any, i int) any {
 	a, ok := v.([]any)
 	if !ok {
@@ -583,6 +908,47 @@ func index(v any, i int) any {
 	return a[i]
 }
 
+func indexOf(value, search any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	for i, v := range a {
+		if equal(v, search) {
+			return int64(i), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func insert(value, position, item any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	i, err := toInteger(position)
+	if err != nil {
+		return nil, err
+	}
+
+	if i < 0 {
+		i = max(i+len(a), 0)
+	} else if i > len(a) {
+		i = len(a)
+	}
+
+	return slices.Insert(slices.Clone(a), i, item), nil
+}
+
 func pruneArray(v any) any {
 	a, ok := v.([]any)
 	if !ok {
@@ -616,6 +982,103 @@ func pruneArray(v any) any {
 	return a
 }
 
+func rangeArray(start, stop any) (any, error) {
+	return rangeStep(start, stop, int64(1))
+}
+
+func rangeStep(start, stop, step any) (any, error) {
+	from, err := toInteger(start)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := toInteger(stop)
+	if err != nil {
+		return nil, err
+	}
+
+	by, err := toInteger(step)
+	if err != nil {
+		return nil, err
+	}
+
+	if by == 0 {
+		return nil, &rangeStepError{}
+	}
+
+	var count uint64
+	if by > 0 && to > from {
+		count = (uint64(to)-uint64(from)-1)/uint64(by) + 1
+	} else if by < 0 && from > to {
+		count = (uint64(from)-uint64(to)-1)/-uint64(by) + 1
+	}
+
+	if count > MaxArrayLength {
+		return nil, &arrayLengthError{
+			length: count,
+		}
+	}
+
+	r := make([]any, count)
+	for i := range r {
+		r[i] = int64(from)
+		from += by
+	}
+
+	return r, nil
+}
+
+func removeAt(value, position any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	i, err := toInteger(position)
+	if err != nil {
+		return nil, err
+	}
+
+	if i < 0 {
+		i += len(a)
+	}
+
+	if i < 0 || i >= len(a) {
+		return a, nil
+	}
+
+	return slices.Delete(slices.Clone(a), i, i+1), nil
+}
+
+func repeat(value, count any) (any, error) {
+	n, err := toInteger(count)
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, &negativeIntegerError{
+			i: n,
+		}
+	}
+
+	if n > MaxArrayLength {
+		return nil, &arrayLengthError{
+			length: uint64(n),
+		}
+	}
+
+	r := make([]any, n)
+	for i := range r {
+		r[i] = value
+	}
+
+	return r, nil
+}
+
 func sortArray(v any) (any, error) {
 	a, ok := v.([]any)
 	if !ok {
@@ -691,3 +1154,76 @@ func sortArray(v any) (any, error) {
 
 	return r, nil
 }
+
+func take(value, count any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	n, err := toInteger(count)
+	if err != nil {
+		return nil, err
+	}
+
+	if n >= 0 {
+		return a[:min(n, len(a))], nil
+	}
+
+	return a[max(len(a)+n, 0):], nil
+}
+
+func toSize(v any, name string) (int, error) {
+	n, err := toInteger(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if n < 0 {
+		return 0, &negativeIntegerError{
+			i: n,
+		}
+	}
+
+	if n == 0 {
+		return 0, &zeroSizeError{
+			name: name,
+		}
+	}
+
+	return n, nil
+}
+
+func window(value, size any) (any, error) {
+	return windowStep(value, size, int64(1))
+}
+
+func windowStep(value, size, step any) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	n, err := toSize(size, "size")
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := toSize(step, "step")
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]any, 0, max(len(a)-n+s, 0)/s)
+	for i := 0; i+n <= len(a); i += s {
+		r = append(r, a[i:i+n:i+n])
+	}
+
+	return r, nil
+}
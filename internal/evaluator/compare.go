@@ -1,7 +1,9 @@
 package evaluator
 
 import (
+	"cmp"
 	"encoding/json"
+	"hash/maphash"
 	"reflect"
 	"strings"
 
@@ -103,31 +105,99 @@ func equal(x, y any) bool {
 }
 
 func greater(x, y any) any {
-	xd, ok := toDecimal(x)
+	c, ok := order(x, y)
 	if !ok {
 		return nil
 	}
 
-	yd, ok := toDecimal(y)
+	return c.Greater()
+}
+
+func greaterOrEqual(x, y any) any {
+	c, ok := order(x, y)
 	if !ok {
 		return nil
 	}
 
-	return xd.Cmp(yd).Greater()
+	return c.GreaterOrEqual()
 }
 
-func greaterOrEqual(x, y any) any {
-	xd, ok := toDecimal(x)
-	if !ok {
-		return nil
+func hash(h *maphash.Hash, v any) {
+	switch v := v.(type) {
+	case nil:
+		h.WriteByte(0)
+		return
+	case bool:
+		h.WriteByte(1)
+		if v {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+
+		return
+	case string:
+		h.WriteByte(2)
+		maphash.WriteComparable(h, len(v))
+		h.WriteString(v)
+		return
+	case []any:
+		h.WriteByte(4)
+		maphash.WriteComparable(h, len(v))
+		for _, i := range v {
+			hash(h, i)
+		}
+
+		return
+	case map[string]any:
+		var sum uint64
+		for k, i := range v {
+			var eh maphash.Hash
+			eh.SetSeed(h.Seed())
+			maphash.WriteComparable(&eh, len(k))
+			eh.WriteString(k)
+			hash(&eh, i)
+			sum += eh.Sum64()
+		}
+
+		h.WriteByte(5)
+		maphash.WriteComparable(h, sum)
+		return
 	}
 
-	yd, ok := toDecimal(y)
+	d, ok := toDecimal(v)
 	if !ok {
-		return nil
+		h.WriteByte(6)
+		return
 	}
 
-	return xd.Cmp(yd).GreaterOrEqual()
+	h.WriteByte(3)
+	if d.IsZero() {
+		h.WriteByte(0)
+		return
+	}
+
+	b, _ := d.Canonical().MarshalBinary()
+	h.WriteByte(byte(len(b)))
+	h.Write(b)
+}
+
+func isMember(value, collection any) (bool, error) {
+	if m, ok := collection.(map[string]any); ok {
+		k, ok := value.(string)
+		if !ok {
+			return false, nil
+		}
+
+		_, ok = m[k]
+		return ok, nil
+	}
+
+	if collection == nil {
+		return false, nil
+	}
+
+	return contains(collection, value)
 }
 
 func isTrue(v any) bool {
@@ -164,29 +234,61 @@ func isTrue(v any) bool {
 }
 
 func less(x, y any) any {
-	xd, ok := toDecimal(x)
+	c, ok := order(x, y)
 	if !ok {
 		return nil
 	}
 
-	yd, ok := toDecimal(y)
+	return c.Less()
+}
+
+func lessOrEqual(x, y any) any {
+	c, ok := order(x, y)
 	if !ok {
 		return nil
 	}
 
-	return xd.Cmp(yd).Less()
+	return c.LessOrEqual()
 }
 
-func lessOrEqual(x, y any) any {
+func order(x, y any) (decimal128.CmpResult, bool) {
+	switch x := x.(type) {
+	case string:
+		y, ok := y.(string)
+		if !ok {
+			return 0, false
+		}
+
+		return decimal128.CmpResult(strings.Compare(x, y)), true
+	case []any:
+		y, ok := y.([]any)
+		if !ok {
+			return 0, false
+		}
+
+		for i := 0; i < len(x) && i < len(y); i++ {
+			c, ok := order(x[i], y[i])
+			if !ok {
+				return 0, false
+			}
+
+			if !c.Equal() {
+				return c, true
+			}
+		}
+
+		return decimal128.CmpResult(cmp.Compare(len(x), len(y))), true
+	}
+
 	xd, ok := toDecimal(x)
 	if !ok {
-		return nil
+		return 0, false
 	}
 
 	yd, ok := toDecimal(y)
 	if !ok {
-		return nil
+		return 0, false
 	}
 
-	return xd.Cmp(yd).LessOrEqual()
+	return xd.Cmp(yd), true
 }
@@ -0,0 +1,208 @@
+package evaluator
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"hash/crc32"
+	"html"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+func base64Decode(value any) (any, error) {
+	return decodeBase64(value, base64.RawStdEncoding, "base64")
+}
+
+func base64Encode(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(s)), nil
+}
+
+func base64URLDecode(value any) (any, error) {
+	return decodeBase64(value, base64.RawURLEncoding, "base64url")
+}
+
+func base64URLEncode(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(s)), nil
+}
+
+func crc32Checksum(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return int64(crc32.ChecksumIEEE([]byte(s))), nil
+}
+
+func decodeBase64(value any, encoding *base64.Encoding, name string) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	b, err := encoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil || !utf8.Valid(b) {
+		return nil, &decodeError{
+			encoding: name,
+			value:    s,
+		}
+	}
+
+	return string(b), nil
+}
+
+func hexDecode(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil || !utf8.Valid(b) {
+		return nil, &decodeError{
+			encoding: "hex",
+			value:    s,
+		}
+	}
+
+	return string(b), nil
+}
+
+func hexEncode(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return hex.EncodeToString([]byte(s)), nil
+}
+
+func htmlEscape(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return html.EscapeString(s), nil
+}
+
+func md5Sum(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func sha1Sum(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	sum := sha1.Sum([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func sha256Sum(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func sha512Sum(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	sum := sha512.Sum512([]byte(s))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func urlDecode(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	r, err := url.QueryUnescape(s)
+	if err != nil || !utf8.ValidString(r) {
+		return nil, &decodeError{
+			encoding: "URL",
+			value:    s,
+		}
+	}
+
+	return r, nil
+}
+
+func urlEncode(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return url.QueryEscape(s), nil
+}
@@ -9,10 +9,12 @@ import (
 )
 
 var (
+	ErrCallDepthExceeded = errors.New("call depth exceeded")
 	ErrInfinity          = errors.New("result of operation is an infinity")
 	ErrInvalidType       = errors.New("invalid type")
 	ErrInvalidValue      = errors.New("invalid value")
 	ErrNotANumber        = errors.New("result of operation is not a number")
+	ErrNotAssignable     = errors.New("expression is not assignable")
 	ErrUndefinedVariable = errors.New("undefined variable")
 )
 
@@ -50,6 +52,104 @@ func (err *UndefinedVariableError) Is(target error) bool {
 	return target == ErrUndefinedVariable
 }
 
+type arrayLengthError struct {
+	length uint64
+}
+
+func (err *arrayLengthError) Error() string {
+	return "array length " + strconv.FormatUint(err.length, 10) + " exceeds maximum of " + strconv.Itoa(MaxArrayLength)
+}
+
+func (err *arrayLengthError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type booleanParseError struct {
+	value string
+}
+
+func (err *booleanParseError) Error() string {
+	return "error converting string to boolean: " + strconv.Quote(err.value)
+}
+
+func (err *booleanParseError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type callDepthError struct {
+	function string
+}
+
+func (err *callDepthError) Error() string {
+	return "maximum call depth exceeded calling function " + strconv.Quote(err.function)
+}
+
+func (err *callDepthError) Is(target error) bool {
+	return target == ErrCallDepthExceeded
+}
+
+type clampRangeError struct {
+	lo decimal128.Decimal
+	hi decimal128.Decimal
+}
+
+func (err *clampRangeError) Error() string {
+	return "lower bound " + err.lo.String() + " is greater than upper bound " + err.hi.String()
+}
+
+func (err *clampRangeError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type decodeError struct {
+	encoding string
+	value    string
+}
+
+func (err *decodeError) Error() string {
+	return "invalid " + err.encoding + " encoded string " + strconv.Quote(err.value)
+}
+
+func (err *decodeError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type formatArgumentError struct {
+	placeholder string
+}
+
+func (err *formatArgumentError) Error() string {
+	return "format placeholder " + err.placeholder + " has no matching argument"
+}
+
+func (err *formatArgumentError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type formatSpecError struct {
+	spec string
+}
+
+func (err *formatSpecError) Error() string {
+	return "invalid format specifier " + strconv.Quote(err.spec)
+}
+
+func (err *formatSpecError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type formatTemplateError struct {
+	template string
+}
+
+func (err *formatTemplateError) Error() string {
+	return "invalid format template " + strconv.Quote(err.template)
+}
+
+func (err *formatTemplateError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
 type fromItemsKeyTypeError struct {
 	key reflect.Type
 }
@@ -86,6 +186,52 @@ func (err *integerConversionError) Is(target error) bool {
 	return target == ErrInvalidValue
 }
 
+type jsonIndentError struct {
+	width int
+}
+
+func (err *jsonIndentError) Error() string {
+	return "JSON indent width " + strconv.Itoa(err.width) + " exceeds maximum of " + strconv.Itoa(maxFormatWidth)
+}
+
+func (err *jsonIndentError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type jsonOptionError struct {
+	option string
+}
+
+func (err *jsonOptionError) Error() string {
+	return "unknown JSON option " + strconv.Quote(err.option)
+}
+
+func (err *jsonOptionError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type jsonParseError struct {
+	value string
+}
+
+func (err *jsonParseError) Error() string {
+	return "invalid JSON text " + strconv.Quote(err.value)
+}
+
+func (err *jsonParseError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type jsonSortKeysError struct{}
+
+func (err *jsonSortKeysError) Error() string {
+	return "JSON object keys are always encoded in sorted order"
+}
+
+func (err *jsonSortKeysError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
 type negativeIntegerError struct {
 	i int
 }
@@ -98,6 +244,49 @@ func (err *negativeIntegerError) Is(target error) bool {
 	return target == ErrInvalidValue
 }
 
+type normalizationFormError struct {
+	form string
+}
+
+func (err *normalizationFormError) Error() string {
+	return "unknown normalization form " + strconv.Quote(err.form)
+}
+
+func (err *normalizationFormError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type notAssignableError struct {
+	op reflect.Type
+}
+
+func (err *notAssignableError) Error() string {
+	var name string
+	if err.op.Kind() == reflect.Pointer {
+		name = err.op.Elem().Name()
+	} else {
+		name = err.op.Name()
+	}
+
+	return "operation " + name + " does not select an assignable location"
+}
+
+func (err *notAssignableError) Is(target error) bool {
+	return target == ErrNotAssignable
+}
+
+type numberParseError struct {
+	value string
+}
+
+func (err *numberParseError) Error() string {
+	return "error converting string to number: " + strconv.Quote(err.value)
+}
+
+func (err *numberParseError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
 type padLengthError struct {
 	pad string
 }
@@ -110,6 +299,53 @@ func (err *padLengthError) Is(target error) bool {
 	return target == ErrInvalidValue
 }
 
+type percentileRangeError struct {
+	p decimal128.Decimal
+}
+
+func (err *percentileRangeError) Error() string {
+	return "percentile " + err.p.String() + " is not between 0 and 100"
+}
+
+func (err *percentileRangeError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type rangeStepError struct{}
+
+func (err *rangeStepError) Error() string {
+	return "range step must not be zero"
+}
+
+func (err *rangeStepError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type regexpError struct {
+	pattern string
+	err     error
+}
+
+func (err *regexpError) Error() string {
+	return "invalid regular expression " + strconv.Quote(err.pattern) + ": " + err.err.Error()
+}
+
+func (err *regexpError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type roundingModeError struct {
+	mode string
+}
+
+func (err *roundingModeError) Error() string {
+	return "unknown rounding mode " + strconv.Quote(err.mode)
+}
+
+func (err *roundingModeError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
 type stringConversionError struct {
 	err error
 }
@@ -122,6 +358,42 @@ func (err *stringConversionError) Unwrap() error {
 	return err.err
 }
 
+type stringLengthError struct {
+	length uint64
+}
+
+func (err *stringLengthError) Error() string {
+	return "string length " + strconv.FormatUint(err.length, 10) + " exceeds maximum of " + strconv.Itoa(MaxStringLength)
+}
+
+func (err *stringLengthError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type timeParseError struct {
+	err error
+}
+
+func (err *timeParseError) Error() string {
+	return "error parsing time: " + err.err.Error()
+}
+
+func (err *timeParseError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+type timeUnitError struct {
+	unit string
+}
+
+func (err *timeUnitError) Error() string {
+	return "unknown time unit " + strconv.Quote(err.unit)
+}
+
+func (err *timeUnitError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
 type unexpectedOperationError struct {
 	op reflect.Type
 }
@@ -136,3 +408,15 @@ func (err *unexpectedOperationError) Error() string {
 
 	return "unexpected operation " + name + " while evaluating expression"
 }
+
+type zeroSizeError struct {
+	name string
+}
+
+func (err *zeroSizeError) Error() string {
+	return err.name + " must be greater than zero"
+}
+
+func (err *zeroSizeError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
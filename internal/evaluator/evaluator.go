@@ -4,20 +4,33 @@ import (
 	"maps"
 	"math"
 	"reflect"
+	"time"
 
 	"github.com/woodsbury/jmespath/internal/parser"
 )
 
-func Evaluate(node parser.Node, data any) (any, error) {
+func Evaluate(node parser.Node, data any, options Options) (any, error) {
 	e := evaluator{
 		root: data,
+		now:  options.Now,
+	}
+
+	if e.now == nil {
+		e.now = time.Now
 	}
 
 	return e.evaluate(node, data, nil)
 }
 
+type Options struct {
+	Now func() time.Time
+}
+
 type evaluator struct {
-	root any
+	root     any
+	now      func() time.Time
+	deleting bool
+	depth    int
 }
 
 func (e *evaluator) evaluate(node parser.Node, current any, variables *variableScope) (any, error) {
@@ -29,6 +42,18 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return abs(arg)
+	case *parser.AddDurationNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return addDuration(arg1, arg2)
 	case *parser.AddNode:
 		left, err := e.evaluate(node.Left, current, variables)
 		if err != nil {
@@ -41,6 +66,13 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return add(left, right)
+	case *parser.AllNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.all(arg1, node.Arguments[1], variables)
 	case *parser.AndNode:
 		left, err := e.evaluate(node.Left, current, variables)
 		if err != nil {
@@ -52,6 +84,13 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return e.evaluate(node.Right, current, variables)
+	case *parser.AnyNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.any(arg1, node.Arguments[1], variables)
 	case *parser.AssertNumberNode:
 		child, err := e.evaluate(node.Child, current, variables)
 		if err != nil {
@@ -70,8 +109,64 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return avg(arg)
+	case *parser.AvgByNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.avgBy(arg1, node.Arguments[1], variables)
+	case *parser.Base64DecodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return base64Decode(arg)
+	case *parser.Base64EncodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return base64Encode(arg)
+	case *parser.Base64URLDecodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return base64URLDecode(arg)
+	case *parser.Base64URLEncodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return base64URLEncode(arg)
 	case parser.BoolNode:
 		return node.Value, nil
+	case *parser.CRC32Node:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return crc32Checksum(arg)
+	case *parser.CamelCaseNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return camelCase(arg)
+	case *parser.CasefoldNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return casefold(arg)
 	case *parser.CeilNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
@@ -79,6 +174,42 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return ceil(arg)
+	case *parser.ChunkNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return chunk(arg1, arg2)
+	case *parser.ClampNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return clamp(arg1, arg2, arg3)
+	case *parser.CompactNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return compact(arg)
 	case *parser.ContainsNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
@@ -91,6 +222,27 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return contains(arg1, arg2)
+	case *parser.CountNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.count(arg1, node.Arguments[1], variables)
+	case *parser.CountByNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.countBy(arg1, node.Arguments[1], variables)
+	case *parser.CumsumNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.cumsum(arg1, node.Arguments[1], variables)
 	case parser.CurrentNode:
 		return current, nil
 	case *parser.DefineVariables:
@@ -104,7 +256,35 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 			results[name] = result
 		}
 
-		return e.evaluate(node.Child, current, variables.new(results))
+		scope := variables.new(results)
+		scope.functions = node.Functions
+		return e.evaluate(node.Child, current, scope)
+	case *parser.DenseRankNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.denseRank(arg1, node.Arguments[1], variables)
+	case *parser.DiffNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.diff(arg1, node.Arguments[1], variables)
+	case *parser.DifferenceNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return difference(arg1, arg2)
 	case *parser.DivideNode:
 		left, err := e.evaluate(node.Left, current, variables)
 		if err != nil {
@@ -117,6 +297,18 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return divide(left, right)
+	case *parser.DropNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return drop(arg1, arg2)
 	case *parser.EndsWithNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
@@ -141,6 +333,25 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return equal(left, right), nil
+	case *parser.EqualsIgnoreCaseNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return equalsIgnoreCase(arg1, arg2)
+	case *parser.ExpNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return exp(arg)
 	case *parser.FieldNode:
 		return field(node.Value, current), nil
 	case *parser.FilterNode:
@@ -159,8 +370,22 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		return e.filterAndProjectArray(left, node.Filter, node.Right, variables)
 	case *parser.FilterAndProjectCurrentNode:
 		return e.filterAndProjectArray(current, node.Filter, node.Child, variables)
+	case *parser.FilterArrayNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.filterArray(arg1, node.Arguments[1], variables)
 	case *parser.FilterCurrentNode:
 		return e.filter(current, node.Filter, variables)
+	case *parser.FindNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.find(arg1, node.Arguments[1], variables)
 	case *parser.FindFirstNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
@@ -212,6 +437,13 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return findFirstFrom(arg1, arg2, arg3)
+	case *parser.FindIndexNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.findIndex(arg1, node.Arguments[1], variables)
 	case *parser.FindLastNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
@@ -281,91 +513,85 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		return e.flattenAndProjectArray(current, node.Child, variables)
 	case parser.FlattenCurrentNode:
 		return flatten(current), nil
-	case *parser.FloorNode:
+	case *parser.FlattenDeepNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return floor(arg)
-	case *parser.FromItemsNode:
-		arg, err := e.evaluate(node.Argument, current, variables)
+		return flattenDeep(arg)
+	case *parser.FlattenDeepDepthNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return fromItems(arg)
-	case *parser.GreaterNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := e.evaluate(node.Right, current, variables)
+		return flattenDeepDepth(arg1, arg2)
+	case *parser.FloorNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return greater(left, right), nil
-	case *parser.GreaterOrEqualNode:
-		left, err := e.evaluate(node.Left, current, variables)
-		if err != nil {
-			return nil, err
-		}
+		return floor(arg)
+	case *parser.FormatNode:
+		args := make([]any, len(node.Arguments))
+		for i, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
 
-		right, err := e.evaluate(node.Right, current, variables)
-		if err != nil {
-			return nil, err
+			args[i] = value
 		}
 
-		return greaterOrEqual(left, right), nil
-	case *parser.GroupByNode:
+		return format(args)
+	case *parser.FormatTimeNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return e.groupBy(arg1, node.Arguments[1], variables)
-	case *parser.IfNode:
-		condition, err := e.evaluate(node.Condition, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		if isTrue(condition) {
-			return e.evaluate(node.Then, current, variables)
-		}
-
-		return e.evaluate(node.Else, current, variables)
-	case *parser.IndexNode:
-		child, err := e.evaluate(node.Child, current, variables)
+		return formatTime(arg1, arg2)
+	case *parser.FromEpochNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return index(child, node.Value), nil
-	case *parser.IndexCurrentNode:
-		return index(current, node.Value), nil
-	case *parser.IntegerDivideNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		return fromEpoch(arg)
+	case *parser.FromEpochMillisNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := e.evaluate(node.Right, current, variables)
+		return fromEpochMillis(arg)
+	case *parser.FromItemsNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return integerDivide(left, right)
-	case *parser.ItemsNode:
+		return fromItems(arg)
+	case *parser.FromJSONNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return items(arg)
-	case *parser.JoinNode:
+		return fromJSON(arg)
+	case *parser.GetNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
@@ -376,61 +602,454 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 			return nil, err
 		}
 
-		return join(arg1, arg2)
-	case *parser.KeysNode:
-		arg, err := e.evaluate(node.Argument, current, variables)
+		return get(arg1, arg2)
+	case *parser.GetDefaultNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return keys(arg)
-	case *parser.LengthNode:
-		arg, err := e.evaluate(node.Argument, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return length(arg)
-	case *parser.LessNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := e.evaluate(node.Right, current, variables)
+		return getDefault(arg1, arg2, arg3)
+	case *parser.GraphemeLengthNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return less(left, right), nil
-	case *parser.LessOrEqualNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		return graphemeLength(arg)
+	case *parser.GraphemeReverseNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := e.evaluate(node.Right, current, variables)
+		return graphemeReverse(arg)
+	case *parser.GraphemeSliceNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return lessOrEqual(left, right), nil
-	case *parser.LowerNode:
-		arg, err := e.evaluate(node.Argument, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return lower(arg)
-	case *parser.MapNode:
-		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		return graphemeSlice(arg1, arg2)
+	case *parser.GraphemeSliceStopNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return e.mapArray(arg2, node.Arguments[0], variables)
-	case *parser.MaxNode:
-		arg, err := e.evaluate(node.Argument, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return graphemeSliceStop(arg1, arg2, arg3)
+	case *parser.GreaterNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return greater(left, right), nil
+	case *parser.GreaterOrEqualNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return greaterOrEqual(left, right), nil
+	case *parser.GreatestNode:
+		args := make([]any, len(node.Arguments))
+		for i, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			args[i] = value
+		}
+
+		return arrayMax(args)
+	case *parser.GroupByNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.groupBy(arg1, node.Arguments[1], variables)
+	case *parser.HTMLEscapeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return htmlEscape(arg)
+	case *parser.HasNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return has(arg1, arg2)
+	case *parser.HexDecodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hexDecode(arg)
+	case *parser.HexEncodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hexEncode(arg)
+	case *parser.IfNode:
+		condition, err := e.evaluate(node.Condition, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(condition) {
+			return e.evaluate(node.Then, current, variables)
+		}
+
+		return e.evaluate(node.Else, current, variables)
+	case *parser.InNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return isMember(left, right)
+	case *parser.IndentNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return indent(arg1, arg2)
+	case *parser.IndexNode:
+		child, err := e.evaluate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return index(child, node.Value), nil
+	case *parser.IndexCurrentNode:
+		return index(current, node.Value), nil
+	case *parser.IndexOfNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return indexOf(arg1, arg2)
+	case *parser.InsertNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return insert(arg1, arg2, arg3)
+	case *parser.IntegerDivideNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return integerDivide(left, right)
+	case *parser.IntersectionNode:
+		args := make([]any, len(node.Arguments))
+		for i, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			args[i] = value
+		}
+
+		return intersection(args)
+	case *parser.IsArrayNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hasType(arg, "array"), nil
+	case *parser.IsBooleanNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hasType(arg, "boolean"), nil
+	case *parser.IsIntegerNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return isInteger(arg), nil
+	case *parser.IsNullNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hasType(arg, "null"), nil
+	case *parser.IsNumberNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hasType(arg, "number"), nil
+	case *parser.IsObjectNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hasType(arg, "object"), nil
+	case *parser.IsStringNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return hasType(arg, "string"), nil
+	case *parser.ItemsNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return items(arg)
+	case *parser.JoinNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return join(arg1, arg2)
+	case *parser.KebabCaseNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return kebabCase(arg)
+	case *parser.KeysNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return keys(arg)
+	case *parser.LagNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.lag(arg1, node.Arguments[1], variables)
+	case *parser.LeadNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.lead(arg1, node.Arguments[1], variables)
+	case *parser.LeastNode:
+		args := make([]any, len(node.Arguments))
+		for i, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			args[i] = value
+		}
+
+		return arrayMin(args)
+	case *parser.LengthNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return length(arg)
+	case *parser.LessNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return less(left, right), nil
+	case *parser.LessOrEqualNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return lessOrEqual(left, right), nil
+	case *parser.LinesNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return lines(arg)
+	case *parser.LnNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return ln(arg)
+	case *parser.LogNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return log(arg)
+	case *parser.LogBaseNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return logBase(arg1, arg2)
+	case *parser.LowerNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return lower(arg)
+	case *parser.MD5Node:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return md5Sum(arg)
+	case *parser.MapNode:
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.mapArray(arg2, node.Arguments[0], variables)
+	case *parser.MapKeysNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.mapKeys(arg1, node.Arguments[1], variables)
+	case *parser.MatchNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		re, err := e.regexp(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return match(left, re), nil
+	case *parser.MaxNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -442,174 +1061,488 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 			return nil, err
 		}
 
-		return e.arrayMaxBy(arg1, node.Arguments[1], variables)
-	case *parser.MergeNode:
-		result := make(map[string]any)
-		for _, arg := range node.Arguments {
-			value, err := e.evaluate(arg, current, variables)
-			if err != nil {
-				return nil, err
-			}
+		return e.arrayMaxBy(arg1, node.Arguments[1], variables)
+	case *parser.MedianNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return median(arg)
+	case *parser.MergeNode:
+		result := make(map[string]any)
+		for _, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			m, ok := value.(map[string]any)
+			if !ok {
+				return nil, &InvalidTypeError{
+					got:  reflect.TypeOf(value),
+					want: "object",
+				}
+			}
+
+			maps.Copy(result, m)
+		}
+
+		return result, nil
+	case *parser.MergeDeepNode:
+		args := make([]any, len(node.Arguments))
+		for i, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			args[i] = value
+		}
+
+		return mergeDeep(args)
+	case *parser.MinNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return arrayMin(arg)
+	case *parser.MinByNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.arrayMinBy(arg1, node.Arguments[1], variables)
+	case *parser.ModeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return mode(arg)
+	case *parser.ModuloNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return modulo(left, right)
+	case *parser.MultiplyNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return multiply(left, right)
+	case *parser.NegateNode:
+		child, err := e.evaluate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if f, ok := toFloat(child); ok {
+			return -f, nil
+		}
+
+		d, ok := toDecimal(child)
+		if !ok {
+			return nil, nil
+		}
+
+		if d.IsZero() {
+			return d, nil
+		}
+
+		return d.Neg(), nil
+	case *parser.NormalizeNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return normalize(arg1, arg2)
+	case *parser.NotNode:
+		child, err := e.evaluate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return !isTrue(child), nil
+	case *parser.NotEqualNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		right, err := e.evaluate(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return !equal(left, right), nil
+	case *parser.NotMatchNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		re, err := e.regexp(node.Right, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return notMatch(left, re), nil
+	case *parser.NotNullNode:
+		for _, arg := range node.Arguments {
+			result, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			if result != nil {
+				return result, nil
+			}
+		}
+
+		return nil, nil
+	case *parser.NotNullValueNode:
+		result, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil {
+			return result, nil
+		}
+
+		return node.Value, nil
+	case parser.NowNode:
+		return e.now().Format(time.RFC3339Nano), nil
+	case parser.NullNode:
+		return nil, nil
+	case *parser.NullCoalesceNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if left != nil {
+			return left, nil
+		}
+
+		return e.evaluate(node.Right, current, variables)
+	case *parser.ObjectValuesNode:
+		child, err := e.evaluate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return objectValues(child), nil
+	case parser.ObjectValuesCurrentNode:
+		return objectValues(current), nil
+	case *parser.OmitNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return omit(arg1, arg2)
+	case *parser.OrNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(left) {
+			return left, nil
+		}
+
+		return e.evaluate(node.Right, current, variables)
+	case *parser.PadLeftNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return padLeft(arg1, arg2, arg3)
+	case *parser.PadRightNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return padRight(arg1, arg2, arg3)
+	case *parser.PadSpaceLeftNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return padSpaceLeft(arg1, arg2)
+	case *parser.PadSpaceRightNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
 
-			m, ok := value.(map[string]any)
-			if !ok {
-				return nil, &InvalidTypeError{
-					got:  reflect.TypeOf(value),
-					want: "object",
-				}
-			}
+		return padSpaceRight(arg1, arg2)
+	case *parser.ParseNumberNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
 
-			maps.Copy(result, m)
+		return parseNumber(arg)
+	case *parser.ParseTimeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
 		}
 
-		return result, nil
-	case *parser.MinNode:
+		return parseTime(arg)
+	case *parser.ParseTimeLayoutNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseTimeLayout(arg1, arg2)
+	case *parser.PascalCaseNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return arrayMin(arg)
-	case *parser.MinByNode:
+		return pascalCase(arg)
+	case *parser.PercentileNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return e.arrayMinBy(arg1, node.Arguments[1], variables)
-	case *parser.ModuloNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := e.evaluate(node.Right, current, variables)
+		return percentile(arg1, arg2)
+	case *parser.PickNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return modulo(left, right)
-	case *parser.MultiplyNode:
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return pick(arg1, arg2)
+	case *parser.PipeNode:
 		left, err := e.evaluate(node.Left, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := e.evaluate(node.Right, current, variables)
+		return e.evaluate(node.Right, left, variables)
+	case *parser.PipeFieldNode:
+		left, err := e.evaluate(node.Left, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return multiply(left, right)
-	case *parser.NegateNode:
-		child, err := e.evaluate(node.Child, current, variables)
+		return field(node.Right, left), nil
+	case *parser.PowNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		if f, ok := toFloat(child); ok {
-			return -f, nil
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
 		}
 
-		d, ok := toDecimal(child)
-		if !ok {
-			return nil, nil
+		return pow(arg1, arg2)
+	case *parser.ProjectArrayNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
 		}
 
-		if d.IsZero() {
-			return d, nil
+		if _, ok := left.(string); ok && isSliceNode(node.Left) {
+			return e.evaluate(node.Right, left, variables)
 		}
 
-		return d.Neg(), nil
-	case *parser.NotNode:
+		return e.projectArray(left, node.Right, variables)
+	case *parser.ProjectArrayCurrentNode:
+		return e.projectArray(current, node.Child, variables)
+	case *parser.ProjectObjectNode:
+		left, err := e.evaluate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.projectObject(left, node.Right, variables)
+	case *parser.ProjectObjectCurrentNode:
+		return e.projectObject(current, node.Child, variables)
+	case *parser.PruneArrayNode:
 		child, err := e.evaluate(node.Child, current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return !isTrue(child), nil
-	case *parser.NotEqualNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		return pruneArray(child), nil
+	case parser.PruneArrayCurrentNode:
+		return pruneArray(current), nil
+	case *parser.RangeNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		right, err := e.evaluate(node.Right, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return !equal(left, right), nil
-	case *parser.NotNullNode:
-		for _, arg := range node.Arguments {
-			result, err := e.evaluate(arg, current, variables)
-			if err != nil {
-				return nil, err
-			}
+		return rangeArray(arg1, arg2)
+	case *parser.RangeStepNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
 
-			if result != nil {
-				return result, nil
-			}
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
 		}
 
-		return nil, nil
-	case *parser.NotNullValueNode:
-		result, err := e.evaluate(node.Argument, current, variables)
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		if result != nil {
-			return result, nil
+		return rangeStep(arg1, arg2, arg3)
+	case *parser.RankNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
 		}
 
-		return node.Value, nil
-	case parser.NullNode:
-		return nil, nil
-	case *parser.ObjectValuesNode:
-		child, err := e.evaluate(node.Child, current, variables)
+		return e.rank(arg1, node.Arguments[1], variables)
+	case *parser.ReduceNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return objectValues(child), nil
-	case parser.ObjectValuesCurrentNode:
-		return objectValues(current), nil
-	case *parser.OrNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		if isTrue(left) {
-			return left, nil
+		return e.reduce(arg1, node.Arguments[1], arg3, variables)
+	case *parser.RegexFindNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
 		}
 
-		return e.evaluate(node.Right, current, variables)
-	case *parser.PadLeftNode:
+		re, err := e.regexp(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return regexFind(arg1, re)
+	case *parser.RegexFindAllNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		re, err := e.regexp(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		return regexFindAll(arg1, re)
+	case *parser.RegexMatchNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return padLeft(arg1, arg2, arg3)
-	case *parser.PadRightNode:
+		re, err := e.regexp(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return regexMatch(arg1, re)
+	case *parser.RegexReplaceNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		re, err := e.regexp(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
@@ -619,20 +1552,22 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 			return nil, err
 		}
 
-		return padRight(arg1, arg2, arg3)
-	case *parser.PadSpaceLeftNode:
+		return regexReplace(arg1, re, arg3)
+	case *parser.RegexSplitNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		re, err := e.regexp(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return padSpaceLeft(arg1, arg2)
-	case *parser.PadSpaceRightNode:
+		return regexSplit(arg1, re)
+	case *parser.RegexpNode:
+		return node.Value.String(), nil
+	case *parser.RemoveAtNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
@@ -643,52 +1578,43 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 			return nil, err
 		}
 
-		return padSpaceRight(arg1, arg2)
-	case *parser.PipeNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		return removeAt(arg1, arg2)
+	case *parser.RenameKeysNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return e.evaluate(node.Right, left, variables)
-	case *parser.PipeFieldNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return field(node.Right, left), nil
-	case *parser.ProjectArrayNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		return renameKeys(arg1, arg2)
+	case *parser.RepeatNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		if _, ok := left.(string); ok && isSliceNode(node.Left) {
-			return e.evaluate(node.Right, left, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
 		}
 
-		return e.projectArray(left, node.Right, variables)
-	case *parser.ProjectArrayCurrentNode:
-		return e.projectArray(current, node.Child, variables)
-	case *parser.ProjectObjectNode:
-		left, err := e.evaluate(node.Left, current, variables)
+		return repeat(arg1, arg2)
+	case *parser.RepeatStringNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return e.projectObject(left, node.Right, variables)
-	case *parser.ProjectObjectCurrentNode:
-		return e.projectObject(current, node.Child, variables)
-	case *parser.PruneArrayNode:
-		child, err := e.evaluate(node.Child, current, variables)
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
 		if err != nil {
 			return nil, err
 		}
 
-		return pruneArray(child), nil
-	case parser.PruneArrayCurrentNode:
-		return pruneArray(current), nil
+		return repeatString(arg1, arg2)
 	case *parser.ReplaceNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
@@ -737,6 +1663,63 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		return reverse(arg)
 	case parser.RootNode:
 		return e.root, nil
+	case *parser.RoundNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return round(arg1, arg2)
+	case *parser.RoundModeNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return roundMode(arg1, arg2, arg3)
+	case *parser.RunningAvgNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.runningAvg(arg1, node.Arguments[1], variables)
+	case *parser.SHA1Node:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return sha1Sum(arg)
+	case *parser.SHA256Node:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return sha256Sum(arg)
+	case *parser.SHA512Node:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return sha512Sum(arg)
 	case *parser.SelectArrayNode:
 		child, err := e.evaluate(node.Child, current, variables)
 		if err != nil {
@@ -770,10 +1753,27 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 				return nil, err
 			}
 
-			results[i] = result
+			results[i] = result
+		}
+
+		return results, nil
+	case *parser.SelectArrayEntriesNode:
+		child, err := e.evaluate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if child == nil {
+			return nil, nil
+		}
+
+		return e.selectArrayEntries(child, node.Entries, variables)
+	case *parser.SelectArrayEntriesCurrentNode:
+		if current == nil {
+			return nil, nil
 		}
 
-		return results, nil
+		return e.selectArrayEntries(current, node.Entries, variables)
 	case *parser.SelectArraySingleNode:
 		child, err := e.evaluate(node.Child, current, variables)
 		if err != nil {
@@ -834,6 +1834,23 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return results, nil
+	case *parser.SelectObjectEntriesNode:
+		child, err := e.evaluate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if child == nil {
+			return nil, nil
+		}
+
+		return e.selectObjectEntries(child, node.Entries, variables)
+	case *parser.SelectObjectEntriesCurrentNode:
+		if current == nil {
+			return nil, nil
+		}
+
+		return e.selectObjectEntries(current, node.Entries, variables)
 	case *parser.SelectObjectSingleNode:
 		child, err := e.evaluate(node.Child, current, variables)
 		if err != nil {
@@ -861,6 +1878,13 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		return map[string]any{
 			node.Key: result,
 		}, nil
+	case *parser.SignNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return sign(arg)
 	case *parser.SliceNode:
 		child, err := e.evaluate(node.Child, current, variables)
 		if err != nil {
@@ -881,6 +1905,13 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		return sliceStep(current, node.Start, node.Stop, node.Step), nil
 	case parser.SmallIndexCurrentNode:
 		return index(current, int(node.Value)), nil
+	case *parser.SnakeCaseNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return snakeCase(arg)
 	case *parser.SortNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
@@ -924,6 +1955,25 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return splitCount(arg1, arg2, arg3)
+	case *parser.SprintfNode:
+		args := make([]any, len(node.Arguments))
+		for i, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			args[i] = value
+		}
+
+		return sprintf(args)
+	case *parser.SqrtNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return sqrt(arg)
 	case *parser.StartsWithNode:
 		arg1, err := e.evaluate(node.Arguments[0], current, variables)
 		if err != nil {
@@ -936,6 +1986,13 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return startsWith(arg1, arg2)
+	case *parser.StddevNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return stddev(arg)
 	case *parser.SubtractNode:
 		left, err := e.evaluate(node.Left, current, variables)
 		if err != nil {
@@ -955,6 +2012,70 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return sum(arg)
+	case *parser.SumByNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.sumBy(arg1, node.Arguments[1], variables)
+	case *parser.SymmetricDifferenceNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return symmetricDifference(arg1, arg2)
+	case *parser.TakeNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return take(arg1, arg2)
+	case *parser.TemplateNode:
+		return e.template(node.Parts, current, variables)
+	case *parser.TimeDiffNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return timeDiff(arg1, arg2)
+	case *parser.TimePartNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return timePart(arg1, arg2)
+	case *parser.TitleCaseNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return titleCase(arg)
 	case *parser.ToArrayNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
@@ -962,6 +2083,65 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return toArray(arg), nil
+	case *parser.ToBooleanNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toBoolean(arg)
+	case *parser.ToEpochNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toEpoch(arg)
+	case *parser.ToEpochMillisNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toEpochMillis(arg)
+	case *parser.ToIntegerNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toIntegral(arg)
+	case *parser.ToIntegerModeNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toIntegralMode(arg1, arg2)
+	case *parser.ToJSONNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toJSON(arg)
+	case *parser.ToJSONOptionsNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toJSONOptions(arg1, arg2)
 	case *parser.ToNumberNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
@@ -969,6 +2149,25 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return toNumber(arg), nil
+	case *parser.ToObjectNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toObject(arg)
+	case *parser.ToObjectKeyNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return toObjectKey(arg1, arg2)
 	case *parser.ToStringNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
@@ -1033,6 +2232,47 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return trimSpaceRight(arg)
+	case *parser.TruncateNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return truncate(arg1, arg2)
+	case *parser.TruncateSuffixNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return truncateSuffix(arg1, arg2, arg3)
+	case *parser.TruncateTimeNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return truncateTime(arg1, arg2)
 	case *parser.TypeNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
@@ -1040,6 +2280,46 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return typeName(arg)
+	case *parser.URLDecodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return urlDecode(arg)
+	case *parser.URLEncodeNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return urlEncode(arg)
+	case *parser.UnionNode:
+		args := make([]any, len(node.Arguments))
+		for i, arg := range node.Arguments {
+			value, err := e.evaluate(arg, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			args[i] = value
+		}
+
+		return union(args)
+	case *parser.UniqueNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return unique(arg)
+	case *parser.UniqueByNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.uniqueBy(arg1, node.Arguments[1], variables)
 	case *parser.UpperNode:
 		arg, err := e.evaluate(node.Argument, current, variables)
 		if err != nil {
@@ -1047,6 +2327,8 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return upper(arg)
+	case *parser.UserFunctionNode:
+		return e.callFunction(node, current, variables)
 	case *parser.ValueNode:
 		return node.Value, nil
 	case *parser.ValuesNode:
@@ -1065,6 +2347,49 @@ func (e *evaluator) evaluate(node parser.Node, current any, variables *variableS
 		}
 
 		return value, nil
+	case *parser.VarianceNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return variance(arg)
+	case *parser.WindowNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return window(arg1, arg2)
+	case *parser.WindowStepNode:
+		arg1, err := e.evaluate(node.Arguments[0], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err := e.evaluate(node.Arguments[1], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		arg3, err := e.evaluate(node.Arguments[2], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return windowStep(arg1, arg2, arg3)
+	case *parser.WordsNode:
+		arg, err := e.evaluate(node.Argument, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return words(arg)
 	case *parser.ZipNode:
 		count := math.MaxInt
 		values := make([][]any, len(node.Arguments))
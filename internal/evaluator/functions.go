@@ -9,6 +9,20 @@ import (
 	"github.com/woodsbury/decimal128"
 )
 
+func hasType(v any, want string) any {
+	t, err := typeName(v)
+	return err == nil && t == want
+}
+
+func isInteger(v any) any {
+	d, ok := toDecimal(v)
+	if !ok {
+		return false
+	}
+
+	return decimal128.Trunc(d).Equal(d)
+}
+
 func length(v any) (any, error) {
 	switch v := v.(type) {
 	case []any:
@@ -36,6 +50,33 @@ func lower(v any) (any, error) {
 	}
 }
 
+func parseDecimal(s string) (decimal128.Decimal, error) {
+	d, err := decimal128.Parse(s)
+	if err != nil || d.IsInf(0) || d.IsNaN() {
+		return decimal128.Decimal{}, &numberParseError{
+			value: s,
+		}
+	}
+
+	return d, nil
+}
+
+func parseNumber(v any) (any, error) {
+	if v == nil || isNumber(v) {
+		return v, nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "string",
+		}
+	}
+
+	return parseDecimal(s)
+}
+
 func reverse(v any) (any, error) {
 	if s, ok := v.(string); ok {
 		var b strings.Builder
@@ -74,6 +115,72 @@ func toArray(v any) any {
 	return []any{v}
 }
 
+func toBoolean(v any) (any, error) {
+	switch v := v.(type) {
+	case nil, bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "yes", "on", "1":
+			return true, nil
+		case "false", "no", "off", "0", "":
+			return false, nil
+		}
+
+		return nil, &booleanParseError{
+			value: v,
+		}
+	}
+
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "boolean",
+		}
+	}
+
+	return !d.IsZero(), nil
+}
+
+func toIntegral(v any) (any, error) {
+	return toIntegralMode(v, "down")
+}
+
+func toIntegralMode(v, mode any) (any, error) {
+	rm, err := roundingMode(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	var d decimal128.Decimal
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		d, err = parseDecimal(value)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		var ok bool
+		d, ok = toDecimal(v)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(v),
+				want: "number",
+			}
+		}
+	}
+
+	r := d.Round(0, rm)
+	if r.IsZero() {
+		return decimal128.Decimal{}, nil
+	}
+
+	return r, nil
+}
+
 func toNumber(v any) any {
 	switch v := v.(type) {
 	case decimal128.Decimal,
@@ -107,6 +214,32 @@ func toNumber(v any) any {
 	return nil
 }
 
+func toObject(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		return v, nil
+	case []any:
+		return fromItems(v)
+	}
+
+	return nil, &InvalidTypeError{
+		got:  reflect.TypeOf(v),
+		want: "object",
+	}
+}
+
+func toObjectKey(v, key any) (any, error) {
+	k, ok := key.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(key),
+			want: "string",
+		}
+	}
+
+	return map[string]any{k: v}, nil
+}
+
 func toString(v any) (any, error) {
 	if s, ok := v.(string); ok {
 		return s, nil
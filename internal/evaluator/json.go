@@ -0,0 +1,128 @@
+package evaluator
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+func encodeJSON(value any, indent string, escapeHTML bool) (any, error) {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(escapeHTML)
+	enc.SetIndent("", indent)
+
+	if err := enc.Encode(value); err != nil {
+		return nil, &stringConversionError{err}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+func fromJSON(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, &jsonParseError{
+			value: s,
+		}
+	}
+
+	if _, err := d.Token(); !errors.Is(err, io.EOF) {
+		return nil, &jsonParseError{
+			value: s,
+		}
+	}
+
+	return v, nil
+}
+
+func toJSON(value any) (any, error) {
+	return encodeJSON(value, "", true)
+}
+
+func toJSONOptions(value, options any) (any, error) {
+	m, ok := options.(map[string]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(options),
+			want: "object",
+		}
+	}
+
+	var indent string
+	escapeHTML := true
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		v := m[k]
+		switch k {
+		case "escape_html":
+			b, ok := v.(bool)
+			if !ok {
+				return nil, &InvalidTypeError{
+					got:  reflect.TypeOf(v),
+					want: "boolean",
+				}
+			}
+
+			escapeHTML = b
+		case "indent":
+			if s, ok := v.(string); ok {
+				indent = s
+				continue
+			}
+
+			n, err := toInteger(v)
+			if err != nil {
+				return nil, err
+			}
+
+			if n < 0 {
+				return nil, &negativeIntegerError{
+					i: n,
+				}
+			}
+
+			if n > maxFormatWidth {
+				return nil, &jsonIndentError{
+					width: n,
+				}
+			}
+
+			indent = strings.Repeat(" ", n)
+		case "sort_keys":
+			// Object keys are always encoded in sorted order, as objects
+			// have no other stable ordering.
+			b, ok := v.(bool)
+			if !ok {
+				return nil, &InvalidTypeError{
+					got:  reflect.TypeOf(v),
+					want: "boolean",
+				}
+			}
+
+			if !b {
+				return nil, &jsonSortKeysError{}
+			}
+		default:
+			return nil, &jsonOptionError{
+				option: k,
+			}
+		}
+	}
+
+	return encodeJSON(value, indent, escapeHTML)
+}
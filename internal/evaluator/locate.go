@@ -0,0 +1,621 @@
+package evaluator
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/woodsbury/jmespath/internal/parser"
+)
+
+type Location struct {
+	Value   any
+	Path    []any
+	Derived bool
+	Sources []Location
+}
+
+func Locate(node parser.Node, data any, options Options) ([]Location, error) {
+	e := evaluator{
+		root: data,
+		now:  options.Now,
+	}
+
+	if e.now == nil {
+		e.now = time.Now
+	}
+
+	result, err := e.locate(node, &located{value: data, found: true}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.appendLeaves(nil), nil
+}
+
+type located struct {
+	value   any
+	path    []any
+	found   bool
+	items   []*located
+	sources []*located
+}
+
+func (l *located) appendLeaves(r []Location) []Location {
+	if l.items != nil {
+		for _, i := range l.items {
+			r = i.appendLeaves(r)
+		}
+
+		return r
+	}
+
+	if l.found {
+		return append(r, Location{
+			Value: l.value,
+			Path:  l.path,
+		})
+	}
+
+	if l.value == nil {
+		return r
+	}
+
+	var sources []Location
+	for _, s := range l.sources {
+		sources = s.appendSources(sources)
+	}
+
+	return append(r, Location{
+		Value:   l.value,
+		Derived: true,
+		Sources: sources,
+	})
+}
+
+func (l *located) appendSources(r []Location) []Location {
+	if l.items != nil {
+		for _, i := range l.items {
+			r = i.appendSources(r)
+		}
+
+		return r
+	}
+
+	if l.found {
+		return append(r, Location{
+			Value: l.value,
+			Path:  l.path,
+		})
+	}
+
+	for _, s := range l.sources {
+		r = s.appendSources(r)
+	}
+
+	return r
+}
+
+func (l *located) element(i int, value any) *located {
+	if l.items != nil {
+		return l.items[i]
+	}
+
+	if l.found {
+		return &located{
+			value: value,
+			path:  append(l.path[:len(l.path):len(l.path)], i),
+			found: true,
+		}
+	}
+
+	return &located{
+		value:   value,
+		sources: []*located{l},
+	}
+}
+
+func (l *located) member(key string) *located {
+	m, ok := l.value.(map[string]any)
+	if !ok {
+		return &located{}
+	}
+
+	value, ok := m[key]
+	if !ok {
+		return &located{}
+	}
+
+	if l.found {
+		return &located{
+			value: value,
+			path:  append(l.path[:len(l.path):len(l.path)], key),
+			found: true,
+		}
+	}
+
+	return &located{
+		value:   value,
+		sources: []*located{l},
+	}
+}
+
+type sourceVisitor struct {
+	e         *evaluator
+	current   *located
+	variables *variableScope
+	sources   []*located
+}
+
+func (v *sourceVisitor) Visit(node parser.Node) {
+	if !isLocationNode(node) {
+		// The value of a derived node is not needed to find its sources, so
+		// walk its children rather than evaluating it again.
+		v.walk(node)
+		return
+	}
+
+	// Sources are best-effort, so a child that cannot be located is left out
+	// rather than failing an expression that has already been evaluated.
+	l, err := v.e.locate(node, v.current, v.variables)
+	if err != nil {
+		return
+	}
+
+	v.sources = append(v.sources, l)
+}
+
+func (v *sourceVisitor) walk(node parser.Node) {
+	// Expression references are applied to other values than the current
+	// one, so only the arguments they are applied to are sources.
+	switch node := node.(type) {
+	case *parser.AllNode:
+		v.Visit(node.Arguments[0])
+	case *parser.AnyNode:
+		v.Visit(node.Arguments[0])
+	case *parser.AvgByNode:
+		v.Visit(node.Arguments[0])
+	case *parser.CountNode:
+		v.Visit(node.Arguments[0])
+	case *parser.CountByNode:
+		v.Visit(node.Arguments[0])
+	case *parser.CumsumNode:
+		v.Visit(node.Arguments[0])
+	case *parser.DenseRankNode:
+		v.Visit(node.Arguments[0])
+	case *parser.DiffNode:
+		v.Visit(node.Arguments[0])
+	case *parser.FilterArrayNode:
+		v.Visit(node.Arguments[0])
+	case *parser.FindNode:
+		v.Visit(node.Arguments[0])
+	case *parser.FindIndexNode:
+		v.Visit(node.Arguments[0])
+	case *parser.GroupByNode:
+		v.Visit(node.Arguments[0])
+	case *parser.LagNode:
+		v.Visit(node.Arguments[0])
+	case *parser.LeadNode:
+		v.Visit(node.Arguments[0])
+	case *parser.MapNode:
+		v.Visit(node.Arguments[1])
+	case *parser.MapKeysNode:
+		v.Visit(node.Arguments[0])
+	case *parser.MaxByNode:
+		v.Visit(node.Arguments[0])
+	case *parser.MinByNode:
+		v.Visit(node.Arguments[0])
+	case *parser.RankNode:
+		v.Visit(node.Arguments[0])
+	case *parser.ReduceNode:
+		v.Visit(node.Arguments[0])
+		v.Visit(node.Arguments[2])
+	case *parser.RunningAvgNode:
+		v.Visit(node.Arguments[0])
+	case *parser.SortByNode:
+		v.Visit(node.Arguments[0])
+	case *parser.SumByNode:
+		v.Visit(node.Arguments[0])
+	case *parser.UniqueByNode:
+		v.Visit(node.Arguments[0])
+	case parser.Walker:
+		node.Walk(v)
+	}
+}
+
+func (e *evaluator) locate(node parser.Node, current *located, variables *variableScope) (*located, error) {
+	switch node := node.(type) {
+	case parser.CurrentNode:
+		return current, nil
+	case *parser.DefineVariables:
+		results := make(map[string]any, len(node.Variables))
+		for name, node := range node.Variables {
+			result, err := e.evaluate(node, current.value, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			results[name] = result
+		}
+
+		scope := variables.new(results)
+		scope.functions = node.Functions
+		return e.locate(node.Child, current, scope)
+	case *parser.FieldNode:
+		return current.member(node.Value), nil
+	case *parser.FilterNode:
+		child, err := e.locate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.locateFilter(child, node.Filter, variables)
+	case *parser.FilterAndProjectNode:
+		left, err := e.locate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.locateFilterAndProject(left, node.Filter, node.Right, variables)
+	case *parser.FilterAndProjectCurrentNode:
+		return e.locateFilterAndProject(current, node.Filter, node.Child, variables)
+	case *parser.FilterCurrentNode:
+		return e.locateFilter(current, node.Filter, variables)
+	case *parser.FlattenNode:
+		child, err := e.locate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.locateFlattenAndProject(child, parser.CurrentNode{}, variables)
+	case *parser.FlattenAndProjectNode:
+		left, err := e.locate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.locateFlattenAndProject(left, node.Right, variables)
+	case *parser.FlattenAndProjectCurrentNode:
+		return e.locateFlattenAndProject(current, node.Child, variables)
+	case parser.FlattenCurrentNode:
+		return e.locateFlattenAndProject(current, parser.CurrentNode{}, variables)
+	case *parser.IndexNode:
+		child, err := e.locate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return locateIndex(child, node.Value), nil
+	case *parser.IndexCurrentNode:
+		return locateIndex(current, node.Value), nil
+	case *parser.ObjectValuesNode:
+		child, err := e.locate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return locateObjectValues(child), nil
+	case parser.ObjectValuesCurrentNode:
+		return locateObjectValues(current), nil
+	case *parser.PipeNode:
+		left, err := e.locate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.locate(node.Right, left, variables)
+	case *parser.PipeFieldNode:
+		left, err := e.locate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return left.member(node.Right), nil
+	case *parser.ProjectArrayNode:
+		left, err := e.locate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := left.value.(string); ok && isSliceNode(node.Left) {
+			return e.locate(node.Right, left, variables)
+		}
+
+		return e.locateProjectArray(left, node.Right, variables)
+	case *parser.ProjectArrayCurrentNode:
+		return e.locateProjectArray(current, node.Child, variables)
+	case *parser.ProjectObjectNode:
+		left, err := e.locate(node.Left, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.locateProjectObject(left, node.Right, variables)
+	case *parser.ProjectObjectCurrentNode:
+		return e.locateProjectObject(current, node.Child, variables)
+	case *parser.PruneArrayNode:
+		child, err := e.locate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.locateProjectArray(child, parser.CurrentNode{}, variables)
+	case parser.PruneArrayCurrentNode:
+		return e.locateProjectArray(current, parser.CurrentNode{}, variables)
+	case parser.RootNode:
+		return &located{value: e.root, found: true}, nil
+	case *parser.SliceNode:
+		child, err := e.locate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return locateSlice(child, node.Start, node.Stop, 1), nil
+	case *parser.SliceCurrentNode:
+		return locateSlice(current, node.Start, node.Stop, 1), nil
+	case *parser.SliceStepNode:
+		child, err := e.locate(node.Child, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return locateSlice(child, node.Start, node.Stop, node.Step), nil
+	case *parser.SliceStepCurrentNode:
+		return locateSlice(current, node.Start, node.Stop, node.Step), nil
+	case parser.SmallIndexCurrentNode:
+		return locateIndex(current, int(node.Value)), nil
+	}
+
+	value, err := e.evaluate(node, current.value, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	v := sourceVisitor{
+		e:         e,
+		current:   current,
+		variables: variables,
+	}
+
+	v.walk(node)
+	return &located{
+		value:   value,
+		sources: v.sources,
+	}, nil
+}
+
+func (e *evaluator) locateFilter(value *located, filter parser.Node, variables *variableScope) (*located, error) {
+	a, ok := value.value.([]any)
+	if !ok {
+		return &located{}, nil
+	}
+
+	items := make([]*located, 0, len(a))
+	for i, v := range a {
+		if v == nil {
+			continue
+		}
+
+		f, err := e.evaluate(filter, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if isTrue(f) {
+			items = append(items, value.element(i, v))
+		}
+	}
+
+	return newProjection(items), nil
+}
+
+func (e *evaluator) locateFilterAndProject(value *located, filter parser.Node, node parser.Node, variables *variableScope) (*located, error) {
+	a, ok := value.value.([]any)
+	if !ok {
+		return &located{}, nil
+	}
+
+	items := make([]*located, 0, len(a))
+	for i, v := range a {
+		f, err := e.evaluate(filter, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isTrue(f) {
+			continue
+		}
+
+		p, err := e.locate(node, value.element(i, v), variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.value != nil {
+			items = append(items, p)
+		}
+	}
+
+	return newProjection(items), nil
+}
+
+func (e *evaluator) locateFlattenAndProject(value *located, node parser.Node, variables *variableScope) (*located, error) {
+	a, ok := value.value.([]any)
+	if !ok {
+		return &located{}, nil
+	}
+
+	items := make([]*located, 0, len(a))
+	for i, v := range a {
+		elem := value.element(i, v)
+		if va, ok := v.([]any); ok {
+			for j, v := range va {
+				p, err := e.locate(node, elem.element(j, v), variables)
+				if err != nil {
+					return nil, err
+				}
+
+				if p.value != nil {
+					items = append(items, p)
+				}
+			}
+
+			continue
+		}
+
+		p, err := e.locate(node, elem, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.value != nil {
+			items = append(items, p)
+		}
+	}
+
+	return newProjection(items), nil
+}
+
+func (e *evaluator) locateProjectArray(value *located, node parser.Node, variables *variableScope) (*located, error) {
+	a, ok := value.value.([]any)
+	if !ok {
+		return &located{}, nil
+	}
+
+	items := make([]*located, 0, len(a))
+	for i, v := range a {
+		if v == nil {
+			continue
+		}
+
+		p, err := e.locate(node, value.element(i, v), variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.value != nil {
+			items = append(items, p)
+		}
+	}
+
+	return newProjection(items), nil
+}
+
+func (e *evaluator) locateProjectObject(value *located, node parser.Node, variables *variableScope) (*located, error) {
+	m, ok := value.value.(map[string]any)
+	if !ok {
+		return &located{}, nil
+	}
+
+	items := make([]*located, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		p, err := e.locate(node, value.member(k), variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.value != nil {
+			items = append(items, p)
+		}
+	}
+
+	return newProjection(items), nil
+}
+
+func isLocationNode(node parser.Node) bool {
+	switch node.(type) {
+	case parser.CurrentNode,
+		*parser.DefineVariables,
+		*parser.FieldNode,
+		*parser.FilterNode,
+		*parser.FilterAndProjectNode,
+		*parser.FilterAndProjectCurrentNode,
+		*parser.FilterCurrentNode,
+		*parser.FlattenNode,
+		*parser.FlattenAndProjectNode,
+		*parser.FlattenAndProjectCurrentNode,
+		parser.FlattenCurrentNode,
+		*parser.IndexNode,
+		*parser.IndexCurrentNode,
+		*parser.ObjectValuesNode,
+		parser.ObjectValuesCurrentNode,
+		*parser.PipeNode,
+		*parser.PipeFieldNode,
+		*parser.ProjectArrayNode,
+		*parser.ProjectArrayCurrentNode,
+		*parser.ProjectObjectNode,
+		*parser.ProjectObjectCurrentNode,
+		*parser.PruneArrayNode,
+		parser.PruneArrayCurrentNode,
+		parser.RootNode,
+		*parser.SliceNode,
+		*parser.SliceCurrentNode,
+		*parser.SliceStepNode,
+		*parser.SliceStepCurrentNode,
+		parser.SmallIndexCurrentNode:
+		return true
+	}
+
+	return false
+}
+
+func locateIndex(value *located, i int) *located {
+	a, ok := value.value.([]any)
+	if !ok {
+		return &located{}
+	}
+
+	if i < 0 {
+		i += len(a)
+	}
+
+	if i < 0 || i >= len(a) {
+		return &located{}
+	}
+
+	return value.element(i, a[i])
+}
+
+func locateObjectValues(value *located) *located {
+	m, ok := value.value.(map[string]any)
+	if !ok {
+		return &located{}
+	}
+
+	items := make([]*located, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		items = append(items, value.member(k))
+	}
+
+	return newProjection(items)
+}
+
+func locateSlice(value *located, start, stop, step int) *located {
+	a, ok := value.value.([]any)
+	if !ok {
+		return &located{
+			value:   sliceStep(value.value, start, stop, step),
+			sources: []*located{value},
+		}
+	}
+
+	indexes := sliceIndexes(len(a), start, stop, step)
+	items := make([]*located, len(indexes))
+	for i, j := range indexes {
+		items[i] = value.element(j, a[j])
+	}
+
+	return newProjection(items)
+}
+
+func newProjection(items []*located) *located {
+	value := make([]any, len(items))
+	for i, item := range items {
+		value[i] = item.value
+	}
+
+	return &located{
+		value: value,
+		items: items,
+	}
+}
@@ -4,10 +4,65 @@ import (
 	"encoding/json"
 	"math"
 	"reflect"
+	"slices"
 
 	"github.com/woodsbury/decimal128"
+	"github.com/woodsbury/jmespath/internal/parser"
 )
 
+func (e *evaluator) avgBy(value any, node parser.Node, variables *variableScope) (any, error) {
+	values, err := e.mapArray(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return avg(values)
+}
+
+func (e *evaluator) countBy(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	r := make(map[string]any, len(a))
+	for _, v := range a {
+		rv, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		var key string
+		if s, ok := rv.(string); ok {
+			key = s
+		} else if d, ok := toDecimal(rv); ok {
+			key = d.Canonical().String()
+		} else {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(rv),
+				want: "string",
+			}
+		}
+
+		n, _ := r[key].(int64)
+		r[key] = n + 1
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) sumBy(value any, node parser.Node, variables *variableScope) (any, error) {
+	values, err := e.mapArray(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return sum(values)
+}
+
 func abs(v any) (any, error) {
 	if f, ok := toFloat(v); ok {
 		return math.Abs(f), nil
@@ -123,6 +178,49 @@ func ceil(v any) (any, error) {
 	return decimal128.Ceil(d), nil
 }
 
+func clamp(value, lo, hi any) (any, error) {
+	d, ok := toDecimal(value)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "number",
+		}
+	}
+
+	lod, ok := toDecimal(lo)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(lo),
+			want: "number",
+		}
+	}
+
+	hid, ok := toDecimal(hi)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(hi),
+			want: "number",
+		}
+	}
+
+	if lod.Cmp(hid).Greater() {
+		return nil, &clampRangeError{
+			lo: lod,
+			hi: hid,
+		}
+	}
+
+	if d.Cmp(lod).Less() {
+		return lo, nil
+	}
+
+	if d.Cmp(hid).Greater() {
+		return hi, nil
+	}
+
+	return value, nil
+}
+
 func divide(x, y any) (any, error) {
 	if xf, yf, ok := toFloatPair(x, y); ok {
 		r := xf / yf
@@ -167,6 +265,28 @@ func divide(x, y any) (any, error) {
 	return r, nil
 }
 
+func exp(v any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	r := decimal128.Exp(d)
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
+
 func floor(v any) (any, error) {
 	if f, ok := toFloat(v); ok {
 		return math.Floor(f), nil
@@ -262,6 +382,153 @@ func isNumber(v any) bool {
 	return false
 }
 
+func ln(v any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	r := decimal128.Log(d)
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
+
+func log(v any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	r := decimal128.Log10(d)
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
+
+func logBase(v, base any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	b, ok := toDecimal(base)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(base),
+			want: "number",
+		}
+	}
+
+	var r decimal128.Decimal
+	switch {
+	case b.Equal(decimal128.FromInt64(2)):
+		r = decimal128.Log2(d)
+	case b.Equal(decimal128.FromInt64(10)):
+		r = decimal128.Log10(d)
+	default:
+		r = decimal128.Log(d).Quo(decimal128.Log(b))
+	}
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
+
+func median(v any) (any, error) {
+	d, err := toSortedDecimals(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(d) == 0 {
+		return nil, nil
+	}
+
+	if len(d)%2 == 1 {
+		return d[len(d)/2], nil
+	}
+
+	r := d[len(d)/2-1].Add(d[len(d)/2]).Quo(decimal128.FromInt64(2))
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	return r, nil
+}
+
+func mode(v any) (any, error) {
+	a, ok := v.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "array",
+		}
+	}
+
+	if len(a) == 0 {
+		return nil, nil
+	}
+
+	buckets := make(map[uint64][]int, len(a))
+	values := make([]any, 0, len(a))
+	counts := make([]int, 0, len(a))
+
+outer:
+	for _, v := range a {
+		key := valueHash(v)
+		for _, i := range buckets[key] {
+			if equal(values[i], v) {
+				counts[i]++
+				continue outer
+			}
+		}
+
+		buckets[key] = append(buckets[key], len(values))
+		values = append(values, v)
+		counts = append(counts, 1)
+	}
+
+	r := 0
+	for i, n := range counts {
+		if n > counts[r] {
+			r = i
+		}
+	}
+
+	return values[r], nil
+}
+
 func modulo(x, y any) (any, error) {
 	if xf, yf, ok := toFloatPair(x, y); ok {
 		r := math.Mod(xf, yf)
@@ -350,6 +617,208 @@ func multiply(x, y any) (any, error) {
 	return r, nil
 }
 
+func percentile(v, p any) (any, error) {
+	d, err := toSortedDecimals(v)
+	if err != nil {
+		return nil, err
+	}
+
+	pd, ok := toDecimal(p)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(p),
+			want: "number",
+		}
+	}
+
+	if pd.IsNaN() || pd.Sign() < 0 || pd.Cmp(decimal128.FromInt64(100)).Greater() {
+		return nil, &percentileRangeError{pd}
+	}
+
+	if len(d) == 0 {
+		return nil, nil
+	}
+
+	rank := pd.Mul(decimal128.FromInt64(int64(len(d) - 1))).Quo(decimal128.FromInt64(100))
+	lower := rank.Floor(0)
+	i, _ := lower.Int64()
+	if i >= int64(len(d)-1) {
+		return d[len(d)-1], nil
+	}
+
+	frac := rank.Sub(lower)
+	if frac.IsZero() {
+		return d[i], nil
+	}
+
+	r := d[i].Add(d[i+1].Sub(d[i]).Mul(frac))
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	return r, nil
+}
+
+func pow(x, y any) (any, error) {
+	xd, ok := toDecimal(x)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(x),
+			want: "number",
+		}
+	}
+
+	yd, ok := toDecimal(y)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(y),
+			want: "number",
+		}
+	}
+
+	r := xd.Pow(yd)
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
+
+func round(value, places any) (any, error) {
+	return roundMode(value, places, "half_up")
+}
+
+func roundMode(value, places, mode any) (any, error) {
+	d, ok := toDecimal(value)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "number",
+		}
+	}
+
+	p, isNum, ok := toInt(places)
+	if !ok {
+		if !isNum {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(places),
+				want: "number",
+			}
+		}
+
+		d, ok := toDecimal(places)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(places),
+				want: "number",
+			}
+		}
+
+		return nil, &integerConversionError{
+			num: d,
+		}
+	}
+
+	rm, err := roundingMode(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	r := d.Round(p, rm)
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
+
+func roundingMode(mode any) (decimal128.RoundingMode, error) {
+	m, ok := mode.(string)
+	if !ok {
+		return 0, &InvalidTypeError{
+			got:  reflect.TypeOf(mode),
+			want: "string",
+		}
+	}
+
+	switch m {
+	case "half_even":
+		return decimal128.ToNearestEven, nil
+	case "half_up":
+		return decimal128.ToNearestAway, nil
+	case "down":
+		return decimal128.ToZero, nil
+	case "up":
+		return decimal128.AwayFromZero, nil
+	case "floor":
+		return decimal128.ToNegativeInf, nil
+	case "ceiling":
+		return decimal128.ToPositiveInf, nil
+	}
+
+	return 0, &roundingModeError{
+		mode: m,
+	}
+}
+
+func sign(v any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	if d.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return int64(d.Sign()), nil
+}
+
+func sqrt(v any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	r := decimal128.Sqrt(d)
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
+
+func stddev(v any) (any, error) {
+	r, err := variance(v)
+	if err != nil || r == nil {
+		return r, err
+	}
+
+	return sqrt(r)
+}
+
 func subtract(x, y any) (any, error) {
 	if xf, yf, ok := toFloatPair(x, y); ok {
 		r := xf - yf
@@ -583,3 +1052,88 @@ func toInt(v any) (int, bool, bool) {
 
 	return 0, false, false
 }
+
+func toInteger(v any) (int, error) {
+	i, isNum, ok := toInt(v)
+	if !ok {
+		if !isNum {
+			return 0, &InvalidTypeError{
+				got:  reflect.TypeOf(v),
+				want: "number",
+			}
+		}
+
+		d, ok := toDecimal(v)
+		if !ok {
+			return 0, &InvalidTypeError{
+				got:  reflect.TypeOf(v),
+				want: "number",
+			}
+		}
+
+		return 0, &integerConversionError{
+			num: d,
+		}
+	}
+
+	return i, nil
+}
+
+func toSortedDecimals(v any) ([]decimal128.Decimal, error) {
+	a, ok := v.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "array",
+		}
+	}
+
+	r := make([]decimal128.Decimal, len(a))
+	for i, v := range a {
+		d, ok := toDecimal(v)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(v),
+				want: "number",
+			}
+		}
+
+		if d.IsNaN() {
+			return nil, ErrNotANumber
+		}
+
+		r[i] = d
+	}
+
+	slices.SortFunc(r, decimal128.Compare)
+	return r, nil
+}
+
+func variance(v any) (any, error) {
+	m, err := avg(v)
+	if err != nil || m == nil {
+		return m, err
+	}
+
+	mean := m.(decimal128.Decimal)
+	a := v.([]any)
+
+	var r decimal128.Decimal
+	for _, v := range a {
+		d, _ := toDecimal(v)
+		diff := d.Sub(mean)
+		r = r.Add(diff.Mul(diff))
+	}
+
+	r = r.Quo(decimal128.FromInt64(int64(len(a))))
+
+	if r.IsInf(0) {
+		return nil, ErrInfinity
+	}
+
+	if r.IsNaN() {
+		return nil, ErrNotANumber
+	}
+
+	return r, nil
+}
@@ -1,7 +1,9 @@
 package evaluator
 
 import (
+	"maps"
 	"reflect"
+	"slices"
 
 	"github.com/woodsbury/jmespath/internal/parser"
 )
@@ -44,6 +46,36 @@ func (e *evaluator) groupBy(value any, node parser.Node, variables *variableScop
 	return r, nil
 }
 
+func (e *evaluator) mapKeys(value any, node parser.Node, variables *variableScope) (any, error) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "object",
+		}
+	}
+
+	r := make(map[string]any, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		rk, err := e.evaluate(node, k, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		s, ok := rk.(string)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(rk),
+				want: "string",
+			}
+		}
+
+		r[s] = m[k]
+	}
+
+	return r, nil
+}
+
 func (e *evaluator) projectObject(value any, node parser.Node, variables *variableScope) (any, error) {
 	m, ok := value.(map[string]any)
 	if !ok {
@@ -67,6 +99,55 @@ func (e *evaluator) projectObject(value any, node parser.Node, variables *variab
 	return r, nil
 }
 
+func (e *evaluator) selectObjectEntries(value any, entries []parser.SelectObjectEntry, variables *variableScope) (any, error) {
+	r := make(map[string]any, len(entries))
+	for _, entry := range entries {
+		if entry.Spread {
+			result, err := e.evaluate(entry.Field, value, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			if result == nil {
+				continue
+			}
+
+			m, ok := result.(map[string]any)
+			if !ok {
+				return nil, &InvalidTypeError{
+					got:  reflect.TypeOf(result),
+					want: "object",
+				}
+			}
+
+			maps.Copy(r, m)
+			continue
+		}
+
+		key, err := e.evaluate(entry.Key, value, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		k, ok := key.(string)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(key),
+				want: "string",
+			}
+		}
+
+		result, err := e.evaluate(entry.Field, value, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		r[k] = result
+	}
+
+	return r, nil
+}
+
 func field(field string, value any) any {
 	m, ok := value.(map[string]any)
 	if !ok {
@@ -114,6 +195,75 @@ func fromItems(v any) (any, error) {
 	return r, nil
 }
 
+func get(value, path any) (any, error) {
+	return getDefault(value, path, nil)
+}
+
+func getDefault(value, path, def any) (any, error) {
+	var p []any
+	switch path := path.(type) {
+	case []any:
+		p = path
+	case string:
+		p = []any{path}
+	default:
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(path),
+			want: "array",
+		}
+	}
+
+	for _, segment := range p {
+		if s, ok := segment.(string); ok {
+			value = field(s, value)
+		} else {
+			i, isNum, ok := toInt(segment)
+			if !ok {
+				if !isNum {
+					return nil, &InvalidTypeError{
+						got:  reflect.TypeOf(segment),
+						want: "string",
+					}
+				}
+
+				d, _ := toDecimal(segment)
+				return nil, &integerConversionError{
+					num: d,
+				}
+			}
+
+			value = index(value, i)
+		}
+	}
+
+	if value == nil {
+		return def, nil
+	}
+
+	return value, nil
+}
+
+func has(value, key any) (any, error) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "object",
+		}
+	}
+
+	k, ok := key.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(key),
+			want: "string",
+		}
+	}
+
+	_, ok = m[k]
+	return ok, nil
+}
+
 func items(v any) (any, error) {
 	m, ok := v.(map[string]any)
 	if !ok {
@@ -152,6 +302,43 @@ func keys(v any) (any, error) {
 	return r, nil
 }
 
+func mergeDeep(values []any) (any, error) {
+	r := make(map[string]any)
+	for _, v := range values {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(v),
+				want: "object",
+			}
+		}
+
+		mergeInto(r, m)
+	}
+
+	return r, nil
+}
+
+func mergeInto(dst, src map[string]any) {
+	for k, v := range src {
+		sm, ok := v.(map[string]any)
+		if !ok {
+			dst[k] = v
+			continue
+		}
+
+		dm, ok := dst[k].(map[string]any)
+		if !ok {
+			dm = make(map[string]any, len(sm))
+		} else {
+			dm = maps.Clone(dm)
+		}
+
+		mergeInto(dm, sm)
+		dst[k] = dm
+	}
+}
+
 func objectValues(v any) any {
 	m, ok := v.(map[string]any)
 	if !ok {
@@ -168,6 +355,123 @@ func objectValues(v any) any {
 	return r
 }
 
+func omit(value, keys any) (any, error) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "object",
+		}
+	}
+
+	k, err := toKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	r := maps.Clone(m)
+	for _, k := range k {
+		delete(r, k)
+	}
+
+	return r, nil
+}
+
+func pick(value, keys any) (any, error) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "object",
+		}
+	}
+
+	k, err := toKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make(map[string]any, len(k))
+	for _, k := range k {
+		if v, ok := m[k]; ok {
+			r[k] = v
+		}
+	}
+
+	return r, nil
+}
+
+func renameKeys(value, mapping any) (any, error) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "object",
+		}
+	}
+
+	names, ok := mapping.(map[string]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(mapping),
+			want: "object",
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := name.(string); !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(name),
+				want: "string",
+			}
+		}
+	}
+
+	r := make(map[string]any, len(m))
+	for k, v := range m {
+		if _, ok := names[k]; !ok {
+			r[k] = v
+		}
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(names)) {
+		if v, ok := m[k]; ok {
+			r[names[k].(string)] = v
+		}
+	}
+
+	return r, nil
+}
+
+func toKeys(v any) ([]string, error) {
+	if s, ok := v.(string); ok {
+		return []string{s}, nil
+	}
+
+	a, ok := v.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "array",
+		}
+	}
+
+	r := make([]string, len(a))
+	for i, k := range a {
+		s, ok := k.(string)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(k),
+				want: "string",
+			}
+		}
+
+		r[i] = s
+	}
+
+	return r, nil
+}
+
 func values(v any) (any, error) {
 	m, ok := v.(map[string]any)
 	if !ok {
@@ -0,0 +1,252 @@
+package evaluator
+
+import (
+	"container/list"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/woodsbury/jmespath/internal/parser"
+)
+
+const regexpCacheSize = 256
+
+var regexpCache = regexpLRU{
+	entries: make(map[string]*list.Element, regexpCacheSize),
+}
+
+func (e *evaluator) regexp(node parser.Node, current any, variables *variableScope) (*regexp.Regexp, error) {
+	if node, ok := node.(*parser.RegexpNode); ok {
+		return node.Value, nil
+	}
+
+	value, err := e.evaluate(node, current, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return compileRegexp(pattern)
+}
+
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexpCache.get(pattern); ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, &regexpError{
+			pattern: pattern,
+			err:     err,
+		}
+	}
+
+	regexpCache.add(pattern, re)
+	return re, nil
+}
+
+func match(value any, re *regexp.Regexp) any {
+	s, ok := value.(string)
+	if !ok {
+		return nil
+	}
+
+	return re.MatchString(s)
+}
+
+func notMatch(value any, re *regexp.Regexp) any {
+	s, ok := value.(string)
+	if !ok {
+		return nil
+	}
+
+	return !re.MatchString(s)
+}
+
+func regexFind(value any, re *regexp.Regexp) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	m := re.FindStringSubmatchIndex(s)
+	if m == nil {
+		return nil, nil
+	}
+
+	return regexGroups(re, s, m), nil
+}
+
+func regexFindAll(value any, re *regexp.Regexp) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	matches := re.FindAllStringSubmatchIndex(s, -1)
+
+	r := make([]any, len(matches))
+	for i, m := range matches {
+		r[i] = regexGroups(re, s, m)
+	}
+
+	return r, nil
+}
+
+func regexGroups(re *regexp.Regexp, s string, m []int) any {
+	n := re.NumSubexp()
+	if n == 0 {
+		return s[m[0]:m[1]]
+	}
+
+	group := func(i int) any {
+		if m[2*i] < 0 {
+			return nil
+		}
+
+		return s[m[2*i]:m[2*i+1]]
+	}
+
+	names := re.SubexpNames()
+	if slices.ContainsFunc(names[1:], func(name string) bool { return name != "" }) {
+		// Unnamed groups are keyed by their index so that mixing them with
+		// named groups does not lose them. Named groups take precedence.
+		r := make(map[string]any, n)
+		for i, name := range names[1:] {
+			if name == "" {
+				r[strconv.Itoa(i+1)] = group(i + 1)
+			}
+		}
+
+		for i, name := range names[1:] {
+			if name != "" {
+				r[name] = group(i + 1)
+			}
+		}
+
+		return r
+	}
+
+	r := make([]any, n)
+	for i := range r {
+		r[i] = group(i + 1)
+	}
+
+	return r
+}
+
+func regexMatch(value any, re *regexp.Regexp) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return re.MatchString(s), nil
+}
+
+func regexReplace(value any, re *regexp.Regexp, replacement any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	p, ok := replacement.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(replacement),
+			want: "string",
+		}
+	}
+
+	return re.ReplaceAllString(s, p), nil
+}
+
+func regexSplit(value any, re *regexp.Regexp) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	if len(s) == 0 {
+		return []any{}, nil
+	}
+
+	parts := re.Split(s, -1)
+
+	r := make([]any, len(parts))
+	for i, part := range parts {
+		r[i] = part
+	}
+
+	return r, nil
+}
+
+type regexpCacheEntry struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+type regexpLRU struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   list.List
+}
+
+func (c *regexpLRU) add(pattern string, re *regexp.Regexp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[pattern]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[pattern] = c.order.PushFront(&regexpCacheEntry{
+		pattern: pattern,
+		re:      re,
+	})
+
+	if c.order.Len() > regexpCacheSize {
+		elem := c.order.Back()
+		c.order.Remove(elem)
+		delete(c.entries, elem.Value.(*regexpCacheEntry).pattern)
+	}
+}
+
+func (c *regexpLRU) get(pattern string) (*regexp.Regexp, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[pattern]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return elem.Value.(*regexpCacheEntry).re, true
+}
@@ -0,0 +1,225 @@
+package evaluator
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/woodsbury/decimal128"
+	"github.com/woodsbury/jmespath/internal/parser"
+)
+
+func (e *evaluator) cumsum(value any, node parser.Node, variables *variableScope) (any, error) {
+	by, err := e.decimalsBy(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]any, len(by))
+	var sum decimal128.Decimal
+	for i, d := range by {
+		sum = sum.Add(d)
+
+		if sum.IsInf(0) {
+			return nil, ErrInfinity
+		}
+
+		if sum.IsNaN() {
+			return nil, ErrNotANumber
+		}
+
+		r[i] = sum
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) decimalsBy(value any, node parser.Node, variables *variableScope) ([]decimal128.Decimal, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	by := make([]decimal128.Decimal, len(a))
+	for i, v := range a {
+		rv, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		d, ok := toDecimal(rv)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(rv),
+				want: "number",
+			}
+		}
+
+		by[i] = d
+	}
+
+	return by, nil
+}
+
+func (e *evaluator) denseRank(value any, node parser.Node, variables *variableScope) (any, error) {
+	return e.rankBy(value, node, variables, true)
+}
+
+func (e *evaluator) diff(value any, node parser.Node, variables *variableScope) (any, error) {
+	by, err := e.decimalsBy(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]any, len(by))
+	for i := 1; i < len(by); i++ {
+		d := by[i].Sub(by[i-1])
+
+		if d.IsInf(0) {
+			return nil, ErrInfinity
+		}
+
+		if d.IsNaN() {
+			return nil, ErrNotANumber
+		}
+
+		r[i] = d
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) lag(value any, node parser.Node, variables *variableScope) (any, error) {
+	by, err := e.mapArray(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	a := by.([]any)
+	r := make([]any, len(a))
+	if len(a) > 0 {
+		copy(r[1:], a[:len(a)-1])
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) lead(value any, node parser.Node, variables *variableScope) (any, error) {
+	by, err := e.mapArray(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	a := by.([]any)
+	r := make([]any, len(a))
+	if len(a) > 0 {
+		copy(r, a[1:])
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) rank(value any, node parser.Node, variables *variableScope) (any, error) {
+	return e.rankBy(value, node, variables, false)
+}
+
+func (e *evaluator) rankBy(value any, node parser.Node, variables *variableScope, dense bool) (any, error) {
+	by, err := e.mapArray(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	a := by.([]any)
+	if len(a) == 0 {
+		return []any{}, nil
+	}
+
+	var compare func(i, j int) int
+	if _, ok := a[0].(string); ok {
+		keys := make([]string, len(a))
+		for i, v := range a {
+			s, ok := v.(string)
+			if !ok {
+				return nil, &InvalidTypeError{
+					got:  reflect.TypeOf(v),
+					want: "string",
+				}
+			}
+
+			keys[i] = s
+		}
+
+		compare = func(i, j int) int {
+			return strings.Compare(keys[i], keys[j])
+		}
+	} else {
+		keys := make([]decimal128.Decimal, len(a))
+		for i, v := range a {
+			d, ok := toDecimal(v)
+			if !ok {
+				return nil, &InvalidTypeError{
+					got:  reflect.TypeOf(v),
+					want: "number",
+				}
+			}
+
+			keys[i] = d
+		}
+
+		compare = func(i, j int) int {
+			return decimal128.Compare(keys[i], keys[j])
+		}
+	}
+
+	order := make([]int, len(a))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, compare)
+
+	r := make([]any, len(a))
+	var n int64
+	for i, j := range order {
+		if i == 0 || compare(order[i-1], j) != 0 {
+			if dense {
+				n++
+			} else {
+				n = int64(i + 1)
+			}
+		}
+
+		r[j] = n
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) runningAvg(value any, node parser.Node, variables *variableScope) (any, error) {
+	by, err := e.decimalsBy(value, node, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]any, len(by))
+	var sum decimal128.Decimal
+	for i, d := range by {
+		sum = sum.Add(d)
+		mean := sum.Quo(decimal128.FromInt64(int64(i + 1)))
+
+		if mean.IsInf(0) {
+			return nil, ErrInfinity
+		}
+
+		if mean.IsNaN() {
+			return nil, ErrNotANumber
+		}
+
+		r[i] = mean
+	}
+
+	return r, nil
+}
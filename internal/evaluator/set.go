@@ -0,0 +1,244 @@
+package evaluator
+
+import (
+	"hash/maphash"
+	"reflect"
+
+	"github.com/woodsbury/jmespath/internal/parser"
+)
+
+var hashSeed = maphash.MakeSeed()
+
+type valueSet struct {
+	buckets map[uint64][]any
+}
+
+func newValueSet(size int) *valueSet {
+	return &valueSet{
+		buckets: make(map[uint64][]any, size),
+	}
+}
+
+func (s *valueSet) add(v any) bool {
+	key := valueHash(v)
+	for _, i := range s.buckets[key] {
+		if equal(i, v) {
+			return false
+		}
+	}
+
+	s.buckets[key] = append(s.buckets[key], v)
+	return true
+}
+
+func (s *valueSet) has(v any) bool {
+	for _, i := range s.buckets[valueHash(v)] {
+		if equal(i, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *evaluator) uniqueBy(value any, node parser.Node, variables *variableScope) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "array",
+		}
+	}
+
+	seen := newValueSet(len(a))
+	r := make([]any, 0, len(a))
+	for _, v := range a {
+		key, err := e.evaluate(node, v, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen.add(key) {
+			r = append(r, v)
+		}
+	}
+
+	return r, nil
+}
+
+func difference(x, y any) (any, error) {
+	xa, ok := x.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(x),
+			want: "array",
+		}
+	}
+
+	ya, ok := y.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(y),
+			want: "array",
+		}
+	}
+
+	seen := newValueSet(len(xa) + len(ya))
+	for _, v := range ya {
+		seen.add(v)
+	}
+
+	r := make([]any, 0, len(xa))
+	for _, v := range xa {
+		if seen.add(v) {
+			r = append(r, v)
+		}
+	}
+
+	return r, nil
+}
+
+func intersection(values []any) (any, error) {
+	arrays, err := toArrays(values)
+	if err != nil {
+		return nil, err
+	}
+
+	sets := make([]*valueSet, len(arrays)-1)
+	for i, a := range arrays[1:] {
+		sets[i] = newValueSet(len(a))
+		for _, v := range a {
+			sets[i].add(v)
+		}
+	}
+
+	seen := newValueSet(len(arrays[0]))
+	r := make([]any, 0, len(arrays[0]))
+
+outer:
+	for _, v := range arrays[0] {
+		for _, s := range sets {
+			if !s.has(v) {
+				continue outer
+			}
+		}
+
+		if seen.add(v) {
+			r = append(r, v)
+		}
+	}
+
+	return r, nil
+}
+
+func symmetricDifference(x, y any) (any, error) {
+	xa, ok := x.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(x),
+			want: "array",
+		}
+	}
+
+	ya, ok := y.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(y),
+			want: "array",
+		}
+	}
+
+	xs := newValueSet(len(xa))
+	for _, v := range xa {
+		xs.add(v)
+	}
+
+	ys := newValueSet(len(ya))
+	for _, v := range ya {
+		ys.add(v)
+	}
+
+	seen := newValueSet(len(xa) + len(ya))
+	r := make([]any, 0, len(xa)+len(ya))
+	for _, v := range xa {
+		if !ys.has(v) && seen.add(v) {
+			r = append(r, v)
+		}
+	}
+
+	for _, v := range ya {
+		if !xs.has(v) && seen.add(v) {
+			r = append(r, v)
+		}
+	}
+
+	return r, nil
+}
+
+func toArrays(values []any) ([][]any, error) {
+	arrays := make([][]any, len(values))
+	for i, v := range values {
+		a, ok := v.([]any)
+		if !ok {
+			return nil, &InvalidTypeError{
+				got:  reflect.TypeOf(v),
+				want: "array",
+			}
+		}
+
+		arrays[i] = a
+	}
+
+	return arrays, nil
+}
+
+func union(values []any) (any, error) {
+	arrays, err := toArrays(values)
+	if err != nil {
+		return nil, err
+	}
+
+	var n int
+	for _, a := range arrays {
+		n += len(a)
+	}
+
+	seen := newValueSet(n)
+	r := make([]any, 0, n)
+	for _, a := range arrays {
+		for _, v := range a {
+			if seen.add(v) {
+				r = append(r, v)
+			}
+		}
+	}
+
+	return r, nil
+}
+
+func unique(v any) (any, error) {
+	a, ok := v.([]any)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "array",
+		}
+	}
+
+	seen := newValueSet(len(a))
+	r := make([]any, 0, len(a))
+	for _, v := range a {
+		if seen.add(v) {
+			r = append(r, v)
+		}
+	}
+
+	return r, nil
+}
+
+func valueHash(v any) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	hash(&h, v)
+	return h.Sum64()
+}
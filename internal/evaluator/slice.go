@@ -90,6 +90,21 @@ func slice(v any, start, stop int) any {
 	return nil
 }
 
+func sliceIndexes(l, start, stop, step int) []int {
+	indexes := make([]any, l)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	selected := sliceStep(indexes, start, stop, step).([]any)
+	r := make([]int, len(selected))
+	for i, v := range selected {
+		r[i] = v.(int)
+	}
+
+	return r
+}
+
 func sliceStep(v any, start, stop, step int) any {
 	if a, ok := v.([]any); ok {
 		l := len(a)
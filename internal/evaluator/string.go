@@ -2,11 +2,64 @@ package evaluator
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/woodsbury/jmespath/internal/parser"
 )
 
+const MaxStringLength = 1 << 24
+
+const maxFormatWidth = 1 << 16
+
+func (e *evaluator) template(parts []parser.Node, current any, variables *variableScope) (any, error) {
+	var b strings.Builder
+	for _, part := range parts {
+		value, err := e.evaluate(part, current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		switch value := value.(type) {
+		case nil:
+		case string:
+			b.WriteString(value)
+		default:
+			s, err := toString(value)
+			if err != nil {
+				return nil, err
+			}
+
+			b.WriteString(s.(string))
+		}
+	}
+
+	return b.String(), nil
+}
+
+func camelCase(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	var b strings.Builder
+	for i, w := range splitWords(s) {
+		if i == 0 {
+			b.WriteString(strings.ToLower(w))
+		} else {
+			writeCapitalised(&b, w)
+		}
+	}
+
+	return b.String(), nil
+}
+
 func endsWith(value, suffix any) (any, error) {
 	s, ok := value.(string)
 	if !ok {
@@ -445,6 +498,245 @@ func findLastFrom(value, sub, start any) (any, error) {
 	return int64(r), nil
 }
 
+func format(args []any) (any, error) {
+	template, ok := args[0].(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(args[0]),
+			want: "string",
+		}
+	}
+
+	args = args[1:]
+
+	var named map[string]any
+	if len(args) > 0 {
+		named, _ = args[0].(map[string]any)
+	}
+
+	var b strings.Builder
+	var next int
+	s := template
+	for {
+		i := strings.IndexAny(s, "{}")
+		if i == -1 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+
+		b.WriteString(s[:i])
+
+		if i+1 < len(s) && s[i+1] == s[i] {
+			b.WriteByte(s[i])
+			s = s[i+2:]
+			continue
+		}
+
+		end := strings.IndexByte(s[i+1:], '}')
+		if s[i] == '}' || end == -1 {
+			return nil, &formatTemplateError{
+				template: template,
+			}
+		}
+
+		field := s[i+1 : i+1+end]
+		s = s[i+2+end:]
+
+		key, spec, _ := strings.Cut(field, ":")
+
+		var value any
+		switch {
+		case key == "":
+			if next >= len(args) {
+				return nil, &formatArgumentError{
+					placeholder: "{" + key + "}",
+				}
+			}
+
+			value = args[next]
+			next++
+		case key[0] >= '0' && key[0] <= '9':
+			n, err := strconv.Atoi(key)
+			if err != nil || n >= len(args) {
+				return nil, &formatArgumentError{
+					placeholder: "{" + key + "}",
+				}
+			}
+
+			value = args[n]
+		default:
+			if named == nil {
+				return nil, &formatArgumentError{
+					placeholder: "{" + key + "}",
+				}
+			}
+
+			value = named[key]
+		}
+
+		if err := formatValue(&b, value, spec); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func formatValue(b *strings.Builder, value any, spec string) error {
+	i := 0
+	for i < len(spec) && strings.IndexByte("-+0 ", spec[i]) != -1 {
+		i++
+	}
+
+	padRight := strings.IndexByte(spec[:i], '-') != -1
+
+	start := i
+	for i < len(spec) && spec[i] >= '0' && spec[i] <= '9' {
+		i++
+	}
+
+	var width int
+	if i > start {
+		n, err := strconv.Atoi(spec[start:i])
+		if err != nil || n > maxFormatWidth {
+			return &formatSpecError{
+				spec: spec,
+			}
+		}
+
+		width = n
+	}
+
+	prec := -1
+	if i < len(spec) && spec[i] == '.' {
+		i++
+
+		start := i
+		for i < len(spec) && spec[i] >= '0' && spec[i] <= '9' {
+			i++
+		}
+
+		n, err := strconv.Atoi(spec[start:i])
+		if err != nil || n > maxFormatWidth {
+			return &formatSpecError{
+				spec: spec,
+			}
+		}
+
+		prec = n
+	}
+
+	var verb byte
+	if i < len(spec) && strings.IndexByte("eEfgG", spec[i]) != -1 {
+		verb = spec[i]
+		i++
+	}
+
+	if i != len(spec) {
+		return &formatSpecError{
+			spec: spec,
+		}
+	}
+
+	if d, ok := toDecimal(value); ok {
+		if verb == 0 {
+			verb = 'g'
+			if prec >= 0 {
+				verb = 'f'
+			}
+
+			spec += string(verb)
+		}
+
+		var buf [64]byte
+		b.Write(d.Append(buf[:0], spec))
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		s = v
+	default:
+		r, err := toString(value)
+		if err != nil {
+			return err
+		}
+
+		s = r.(string)
+	}
+
+	if prec >= 0 {
+		n := 0
+		for j := 0; j < prec && n < len(s); j++ {
+			_, sz := utf8.DecodeRuneInString(s[n:])
+			n += sz
+		}
+
+		s = s[:n]
+	}
+
+	n := width - utf8.RuneCountInString(s)
+	if padRight {
+		b.WriteString(s)
+	}
+
+	for ; n > 0; n-- {
+		b.WriteByte(' ')
+	}
+
+	if !padRight {
+		b.WriteString(s)
+	}
+
+	return nil
+}
+
+func indent(value, width any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	n, err := toInteger(width)
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, &negativeIntegerError{
+			i: n,
+		}
+	}
+
+	if n > MaxStringLength {
+		return nil, &stringLengthError{
+			length: uint64(n),
+		}
+	}
+
+	if length := uint64(n)*uint64(strings.Count(s, "\n")+1) + uint64(len(s)); length > MaxStringLength {
+		return nil, &stringLengthError{
+			length: length,
+		}
+	}
+
+	prefix := strings.Repeat(" ", n)
+
+	var b strings.Builder
+	for line := range strings.Lines(s) {
+		if strings.TrimRight(line, "\r\n") != "" {
+			b.WriteString(prefix)
+		}
+
+		b.WriteString(line)
+	}
+
+	return b.String(), nil
+}
+
 func join(sep, value any) (any, error) {
 	a, ok := value.([]any)
 	if !ok {
@@ -493,6 +785,40 @@ func join(sep, value any) (any, error) {
 	return b.String(), nil
 }
 
+func joinWords(value any, sep string) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return strings.ToLower(strings.Join(splitWords(s), sep)), nil
+}
+
+func kebabCase(value any) (any, error) {
+	return joinWords(value, "-")
+}
+
+func lines(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	r := []any{}
+	for line := range strings.Lines(s) {
+		line = strings.TrimSuffix(line, "\n")
+		r = append(r, strings.TrimSuffix(line, "\r"))
+	}
+
+	return r, nil
+}
+
 func padLeft(value, width, pad any) (any, error) {
 	s, ok := value.(string)
 	if !ok {
@@ -733,6 +1059,52 @@ func padSpaceRight(value, width any) (any, error) {
 	return b.String(), nil
 }
 
+func pascalCase(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	var b strings.Builder
+	for _, w := range splitWords(s) {
+		writeCapitalised(&b, w)
+	}
+
+	return b.String(), nil
+}
+
+func repeatString(value, count any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	n, err := toInteger(count)
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, &negativeIntegerError{
+			i: n,
+		}
+	}
+
+	if s != "" && n > MaxStringLength/len(s) {
+		return nil, &stringLengthError{
+			length: uint64(len(s)) * uint64(n),
+		}
+	}
+
+	return strings.Repeat(s, n), nil
+}
+
 func replace(value, old, new any) (any, error) {
 	s, ok := value.(string)
 	if !ok {
@@ -811,6 +1183,10 @@ func replaceCount(value, old, new, count any) (any, error) {
 	return strings.Replace(s, po, pn, n), nil
 }
 
+func snakeCase(value any) (any, error) {
+	return joinWords(value, "_")
+}
+
 func split(value, sep any) (any, error) {
 	s, ok := value.(string)
 	if !ok {
@@ -953,6 +1329,133 @@ func splitCount(value, sep, count any) (any, error) {
 	return r[:i+1], nil
 }
 
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start != -1 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+
+			continue
+		}
+
+		if start != -1 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			if !unicode.IsUpper(prev) || i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+
+		if start == -1 {
+			start = i
+		}
+	}
+
+	if start != -1 {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
+
+func sprintf(args []any) (any, error) {
+	template, ok := args[0].(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(args[0]),
+			want: "string",
+		}
+	}
+
+	args = args[1:]
+
+	var b strings.Builder
+	var next int
+	s := template
+	for {
+		i := strings.IndexByte(s, '%')
+		if i == -1 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+
+		b.WriteString(s[:i])
+		s = s[i+1:]
+
+		i = 0
+		for i < len(s) && strings.IndexByte("-+0 .123456789", s[i]) != -1 {
+			i++
+		}
+
+		if i == len(s) {
+			return nil, &formatSpecError{
+				spec: "%" + s,
+			}
+		}
+
+		spec, verb := s[:i], s[i]
+		s = s[i+1:]
+
+		if verb == '%' {
+			if spec != "" {
+				return nil, &formatSpecError{
+					spec: "%" + spec + "%",
+				}
+			}
+
+			b.WriteByte('%')
+			continue
+		}
+
+		if next >= len(args) {
+			return nil, &formatArgumentError{
+				placeholder: "%" + spec + string(verb),
+			}
+		}
+
+		value := args[next]
+		next++
+
+		switch verb {
+		case 'd':
+			if strings.IndexByte(spec, '.') != -1 {
+				return nil, &formatSpecError{
+					spec: "%" + spec + string(verb),
+				}
+			}
+
+			if _, err := toInteger(value); err != nil {
+				return nil, err
+			}
+
+			spec += ".0f"
+		case 'e', 'E', 'f', 'g', 'G':
+			if !isNumber(value) {
+				return nil, &InvalidTypeError{
+					got:  reflect.TypeOf(value),
+					want: "number",
+				}
+			}
+
+			spec += string(verb)
+		case 's', 'v':
+		default:
+			return nil, &formatSpecError{
+				spec: "%" + spec + string(verb),
+			}
+		}
+
+		if err := formatValue(&b, value, spec); err != nil {
+			return nil, err
+		}
+	}
+}
+
 func startsWith(value, prefix any) (any, error) {
 	s, ok := value.(string)
 	if !ok {
@@ -1080,3 +1583,71 @@ func trimSpaceRight(value any) (any, error) {
 
 	return strings.TrimRightFunc(s, unicode.IsSpace), nil
 }
+
+func truncate(value, width any) (any, error) {
+	return truncateSuffix(value, width, "...")
+}
+
+func truncateSuffix(value, width, suffix any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	n, err := toInteger(width)
+	if err != nil {
+		return nil, err
+	}
+
+	if n < 0 {
+		return nil, &negativeIntegerError{
+			i: n,
+		}
+	}
+
+	sfx, ok := suffix.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(suffix),
+			want: "string",
+		}
+	}
+
+	if utf8.RuneCountInString(s) <= n {
+		return s, nil
+	}
+
+	keep := n - utf8.RuneCountInString(sfx)
+	if keep < 0 {
+		return string([]rune(sfx)[:n]), nil
+	}
+
+	return string([]rune(s)[:keep]) + sfx, nil
+}
+
+func words(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	split := splitWords(s)
+	r := make([]any, len(split))
+	for i, w := range split {
+		r[i] = w
+	}
+
+	return r, nil
+}
+
+func writeCapitalised(b *strings.Builder, word string) {
+	r, sz := utf8.DecodeRuneInString(word)
+	b.WriteRune(unicode.ToTitle(r))
+	b.WriteString(strings.ToLower(word[sz:]))
+}
@@ -0,0 +1,335 @@
+package evaluator
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/woodsbury/decimal128"
+)
+
+var timeLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"DateOnly":    time.DateOnly,
+	"DateTime":    time.DateTime,
+	"Kitchen":     time.Kitchen,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"TimeOnly":    time.TimeOnly,
+}
+
+func addDuration(value, duration any) (any, error) {
+	t, err := toTime(value)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := toDuration(duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromTime(t.Add(d), value), nil
+}
+
+func decimalToTime(d decimal128.Decimal) (time.Time, error) {
+	if d.IsNaN() || d.IsInf(0) {
+		return time.Time{}, &integerConversionError{d}
+	}
+
+	sec := d.Floor(0)
+	s, ok := sec.Int64()
+	if !ok {
+		return time.Time{}, &integerConversionError{d}
+	}
+
+	ns, _ := d.Sub(sec).Mul(decimal128.New(1, 9)).Round(0, decimal128.ToNearestEven).Int64()
+	return time.Unix(s, ns).UTC(), nil
+}
+
+func formatTime(value, layout any) (any, error) {
+	t, err := toTime(value)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := toLayout(layout)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Format(l), nil
+}
+
+func fromEpoch(v any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	t, err := decimalToTime(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Format(time.RFC3339Nano), nil
+}
+
+func fromEpochMillis(v any) (any, error) {
+	d, ok := toDecimal(v)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "number",
+		}
+	}
+
+	t, err := decimalToTime(d.Quo(decimal128.New(1, 3)))
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Format(time.RFC3339Nano), nil
+}
+
+func fromTime(t time.Time, like any) any {
+	if _, ok := like.(string); ok {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	return timeToDecimal(t)
+}
+
+func parseTime(v any) (any, error) {
+	t, err := toTime(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Format(time.RFC3339Nano), nil
+}
+
+func parseTimeLayout(value, layout any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	l, err := toLayout(layout)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := time.Parse(l, s)
+	if err != nil {
+		return nil, &timeParseError{err}
+	}
+
+	return t.Format(time.RFC3339Nano), nil
+}
+
+func timeDiff(x, y any) (any, error) {
+	tx, err := toTime(x)
+	if err != nil {
+		return nil, err
+	}
+
+	ty, err := toTime(y)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeToDecimal(tx).Sub(timeToDecimal(ty)).Canonical(), nil
+}
+
+func timePart(value, part any) (any, error) {
+	t, err := toTime(value)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := part.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(part),
+			want: "string",
+		}
+	}
+
+	switch p {
+	case "year":
+		return int64(t.Year()), nil
+	case "month":
+		return int64(t.Month()), nil
+	case "day":
+		return int64(t.Day()), nil
+	case "hour":
+		return int64(t.Hour()), nil
+	case "minute":
+		return int64(t.Minute()), nil
+	case "second":
+		return int64(t.Second()), nil
+	case "millisecond":
+		return int64(t.Nanosecond() / int(time.Millisecond)), nil
+	case "nanosecond":
+		return int64(t.Nanosecond()), nil
+	case "weekday":
+		if t.Weekday() == time.Sunday {
+			return int64(7), nil
+		}
+
+		return int64(t.Weekday()), nil
+	case "yearday":
+		return int64(t.YearDay()), nil
+	case "week":
+		_, week := t.ISOWeek()
+		return int64(week), nil
+	case "offset":
+		_, offset := t.Zone()
+		return int64(offset), nil
+	}
+
+	return nil, &timeUnitError{p}
+}
+
+func timeToDecimal(t time.Time) decimal128.Decimal {
+	d := decimal128.FromInt64(t.Unix())
+	if ns := t.Nanosecond(); ns != 0 {
+		d = d.Add(decimal128.New(int64(ns), -9)).Canonical()
+	}
+
+	return d
+}
+
+func toDuration(v any) (time.Duration, error) {
+	if s, ok := v.(string); ok {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, &timeParseError{err}
+		}
+
+		return d, nil
+	}
+
+	d, ok := toDecimal(v)
+	if !ok {
+		return 0, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "string or number",
+		}
+	}
+
+	if d.IsNaN() {
+		return 0, &integerConversionError{d}
+	}
+
+	ns, ok := d.Mul(decimal128.New(1, 9)).Round(0, decimal128.ToNearestEven).Int64()
+	if !ok {
+		return 0, &integerConversionError{d}
+	}
+
+	return time.Duration(ns), nil
+}
+
+func toEpoch(v any) (any, error) {
+	t, err := toTime(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeToDecimal(t), nil
+}
+
+func toEpochMillis(v any) (any, error) {
+	t, err := toTime(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.UnixMilli(), nil
+}
+
+func toLayout(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "string",
+		}
+	}
+
+	if layout, ok := timeLayouts[s]; ok {
+		return layout, nil
+	}
+
+	return s, nil
+}
+
+func toTime(v any) (time.Time, error) {
+	if s, ok := v.(string); ok {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return time.Time{}, &timeParseError{err}
+		}
+
+		return t, nil
+	}
+
+	d, ok := toDecimal(v)
+	if !ok {
+		return time.Time{}, &InvalidTypeError{
+			got:  reflect.TypeOf(v),
+			want: "string or number",
+		}
+	}
+
+	return decimalToTime(d)
+}
+
+func truncateTime(value, unit any) (any, error) {
+	t, err := toTime(value)
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := unit.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(unit),
+			want: "string",
+		}
+	}
+
+	year, month, day := t.Date()
+	switch u {
+	case "second":
+		t = time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	case "minute":
+		t = time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, t.Location())
+	case "hour":
+		t = time.Date(year, month, day, t.Hour(), 0, 0, 0, t.Location())
+	case "day":
+		t = time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case "week":
+		offset := (int(t.Weekday()) + 6) % 7
+		t = time.Date(year, month, day-offset, 0, 0, 0, 0, t.Location())
+	case "month":
+		t = time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	case "year":
+		t = time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return nil, &timeUnitError{u}
+	}
+
+	return fromTime(t, value), nil
+}
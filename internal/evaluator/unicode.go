@@ -0,0 +1,183 @@
+package evaluator
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+func casefold(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return foldString(s), nil
+}
+
+func equalsIgnoreCase(left, right any) (any, error) {
+	l, ok := left.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(left),
+			want: "string",
+		}
+	}
+
+	r, ok := right.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(right),
+			want: "string",
+		}
+	}
+
+	return foldString(l) == foldString(r), nil
+}
+
+func foldString(s string) string {
+	return norm.NFC.String(cases.Fold().String(norm.NFD.String(s)))
+}
+
+func graphemeLength(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return int64(uniseg.GraphemeClusterCount(s)), nil
+}
+
+func graphemeReverse(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	clusters := graphemes(s)
+	slices.Reverse(clusters)
+	return strings.Join(clusters, ""), nil
+}
+
+func graphemeSlice(value, start any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	i, err := toInteger(start)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := graphemes(s)
+	return joinGraphemes(clusters, i, len(clusters)), nil
+}
+
+func graphemeSliceStop(value, start, stop any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	i, err := toInteger(start)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := toInteger(stop)
+	if err != nil {
+		return nil, err
+	}
+
+	return joinGraphemes(graphemes(s), i, j), nil
+}
+
+func graphemes(s string) []string {
+	var r []string
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		r = append(r, g.Str())
+	}
+
+	return r
+}
+
+func joinGraphemes(clusters []string, start, stop int) string {
+	a := make([]any, len(clusters))
+	for i, c := range clusters {
+		a[i] = c
+	}
+
+	var b strings.Builder
+	for _, c := range slice(a, start, stop).([]any) {
+		b.WriteString(c.(string))
+	}
+
+	return b.String()
+}
+
+func normalize(value, form any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	f, ok := form.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(form),
+			want: "string",
+		}
+	}
+
+	switch f {
+	case "NFC":
+		return norm.NFC.String(s), nil
+	case "NFD":
+		return norm.NFD.String(s), nil
+	case "NFKC":
+		return norm.NFKC.String(s), nil
+	case "NFKD":
+		return norm.NFKD.String(s), nil
+	}
+
+	return nil, &normalizationFormError{
+		form: f,
+	}
+}
+
+func titleCase(value any) (any, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, &InvalidTypeError{
+			got:  reflect.TypeOf(value),
+			want: "string",
+		}
+	}
+
+	return cases.Title(language.Und).String(s), nil
+}
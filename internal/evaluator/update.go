@@ -0,0 +1,479 @@
+package evaluator
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/woodsbury/jmespath/internal/parser"
+)
+
+type deletedValue struct{}
+
+type updateFunc func(any) (any, error)
+
+func Assignable(node parser.Node) error {
+	switch node := node.(type) {
+	case parser.CurrentNode,
+		*parser.FieldNode,
+		*parser.FilterCurrentNode,
+		parser.FlattenCurrentNode,
+		*parser.IndexCurrentNode,
+		parser.ObjectValuesCurrentNode,
+		parser.PruneArrayCurrentNode,
+		*parser.SliceCurrentNode,
+		*parser.SliceStepCurrentNode,
+		parser.SmallIndexCurrentNode:
+		return nil
+	case *parser.DefineVariables:
+		return Assignable(node.Child)
+	case *parser.FilterNode:
+		return Assignable(node.Child)
+	case *parser.FilterAndProjectNode:
+		if err := Assignable(node.Left); err != nil {
+			return err
+		}
+
+		return Assignable(node.Right)
+	case *parser.FilterAndProjectCurrentNode:
+		return Assignable(node.Child)
+	case *parser.FlattenNode:
+		return Assignable(node.Child)
+	case *parser.FlattenAndProjectNode:
+		if err := Assignable(node.Left); err != nil {
+			return err
+		}
+
+		return Assignable(node.Right)
+	case *parser.FlattenAndProjectCurrentNode:
+		return Assignable(node.Child)
+	case *parser.IndexNode:
+		return assignableLocation(node, node.Child)
+	case *parser.ObjectValuesNode:
+		return assignableLocation(node, node.Child)
+	case *parser.PipeNode:
+		if err := assignableLocation(node, node.Left); err != nil {
+			return err
+		}
+
+		return Assignable(node.Right)
+	case *parser.PipeFieldNode:
+		return assignableLocation(node, node.Left)
+	case *parser.ProjectArrayNode:
+		if err := Assignable(node.Left); err != nil {
+			return err
+		}
+
+		return Assignable(node.Right)
+	case *parser.ProjectArrayCurrentNode:
+		return Assignable(node.Child)
+	case *parser.ProjectObjectNode:
+		if err := assignableLocation(node, node.Left); err != nil {
+			return err
+		}
+
+		return Assignable(node.Right)
+	case *parser.ProjectObjectCurrentNode:
+		return Assignable(node.Child)
+	case *parser.PruneArrayNode:
+		return Assignable(node.Child)
+	case *parser.SliceNode:
+		return assignableLocation(node, node.Child)
+	case *parser.SliceStepNode:
+		return assignableLocation(node, node.Child)
+	}
+
+	return &notAssignableError{reflect.TypeOf(node)}
+}
+
+func Delete(node parser.Node, data any, options Options) (any, error) {
+	e := evaluator{
+		root:     data,
+		now:      options.Now,
+		deleting: true,
+	}
+
+	if e.now == nil {
+		e.now = time.Now
+	}
+
+	result, err := e.update(node, data, nil, func(any) (any, error) {
+		return deletedValue{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result == (deletedValue{}) {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+func Update(node parser.Node, data any, fn func(any) any, options Options) (any, error) {
+	e := evaluator{
+		root: data,
+		now:  options.Now,
+	}
+
+	if e.now == nil {
+		e.now = time.Now
+	}
+
+	return e.update(node, data, nil, func(v any) (any, error) {
+		return fn(v), nil
+	})
+}
+
+func (e *evaluator) update(node parser.Node, current any, variables *variableScope, fn updateFunc) (any, error) {
+	switch node := node.(type) {
+	case parser.CurrentNode:
+		return fn(current)
+	case *parser.DefineVariables:
+		results := make(map[string]any, len(node.Variables))
+		for name, node := range node.Variables {
+			result, err := e.evaluate(node, current, variables)
+			if err != nil {
+				return nil, err
+			}
+
+			results[name] = result
+		}
+
+		scope := variables.new(results)
+		scope.functions = node.Functions
+		return e.update(node.Child, current, scope, fn)
+	case *parser.FieldNode:
+		return e.updateField(current, node.Value, fn)
+	case *parser.FilterNode:
+		return e.updateEach(node.Child, current, variables, func(v any) (any, error) {
+			return e.updateFiltered(v, node.Filter, variables, fn)
+		})
+	case *parser.FilterAndProjectNode:
+		return e.updateEach(node.Left, current, variables, func(v any) (any, error) {
+			return e.updateFiltered(v, node.Filter, variables, func(v any) (any, error) {
+				return e.updateProjected(v, node.Right, variables, fn)
+			})
+		})
+	case *parser.FilterAndProjectCurrentNode:
+		return updateArray(current, func(v any) (any, error) {
+			return e.updateFiltered(v, node.Filter, variables, func(v any) (any, error) {
+				return e.updateProjected(v, node.Child, variables, fn)
+			})
+		})
+	case *parser.FilterCurrentNode:
+		return updateArray(current, func(v any) (any, error) {
+			return e.updateFiltered(v, node.Filter, variables, fn)
+		})
+	case *parser.FlattenNode:
+		return e.updateEach(node.Child, current, variables, func(v any) (any, error) {
+			return updateFlattened(v, fn)
+		})
+	case *parser.FlattenAndProjectNode:
+		return e.updateEach(node.Left, current, variables, func(v any) (any, error) {
+			return updateFlattened(v, func(v any) (any, error) {
+				return e.updateProjected(v, node.Right, variables, fn)
+			})
+		})
+	case *parser.FlattenAndProjectCurrentNode:
+		return updateArray(current, func(v any) (any, error) {
+			return updateFlattened(v, func(v any) (any, error) {
+				return e.updateProjected(v, node.Child, variables, fn)
+			})
+		})
+	case parser.FlattenCurrentNode:
+		return updateArray(current, func(v any) (any, error) {
+			return updateFlattened(v, fn)
+		})
+	case *parser.IndexNode:
+		return e.update(node.Child, current, variables, func(v any) (any, error) {
+			return updateIndex(v, node.Value, fn)
+		})
+	case *parser.IndexCurrentNode:
+		return updateIndex(current, node.Value, fn)
+	case *parser.ObjectValuesNode:
+		return e.update(node.Child, current, variables, func(v any) (any, error) {
+			return updateObject(v, fn)
+		})
+	case parser.ObjectValuesCurrentNode:
+		return updateObject(current, fn)
+	case *parser.PipeNode:
+		return e.update(node.Left, current, variables, func(v any) (any, error) {
+			return e.update(node.Right, v, variables, fn)
+		})
+	case *parser.PipeFieldNode:
+		return e.update(node.Left, current, variables, func(v any) (any, error) {
+			return e.updateField(v, node.Right, fn)
+		})
+	case *parser.ProjectArrayNode:
+		return e.updateEach(node.Left, current, variables, func(v any) (any, error) {
+			return e.updateProjected(v, node.Right, variables, fn)
+		})
+	case *parser.ProjectArrayCurrentNode:
+		return updateArray(current, func(v any) (any, error) {
+			return e.updateProjected(v, node.Child, variables, fn)
+		})
+	case *parser.ProjectObjectNode:
+		return e.update(node.Left, current, variables, func(v any) (any, error) {
+			return updateObject(v, func(v any) (any, error) {
+				return e.updateProjected(v, node.Right, variables, fn)
+			})
+		})
+	case *parser.ProjectObjectCurrentNode:
+		return updateObject(current, func(v any) (any, error) {
+			return e.updateProjected(v, node.Child, variables, fn)
+		})
+	case *parser.PruneArrayNode:
+		return e.updateEach(node.Child, current, variables, func(v any) (any, error) {
+			return updateNonNull(v, fn)
+		})
+	case parser.PruneArrayCurrentNode:
+		return updateArray(current, func(v any) (any, error) {
+			return updateNonNull(v, fn)
+		})
+	case *parser.SliceNode:
+		return e.update(node.Child, current, variables, func(v any) (any, error) {
+			return updateSlice(v, node.Start, node.Stop, 1, fn)
+		})
+	case *parser.SliceCurrentNode:
+		return updateSlice(current, node.Start, node.Stop, 1, fn)
+	case *parser.SliceStepNode:
+		return e.update(node.Child, current, variables, func(v any) (any, error) {
+			return updateSlice(v, node.Start, node.Stop, node.Step, fn)
+		})
+	case *parser.SliceStepCurrentNode:
+		return updateSlice(current, node.Start, node.Stop, node.Step, fn)
+	case parser.SmallIndexCurrentNode:
+		return updateIndex(current, int(node.Value), fn)
+	}
+
+	return nil, &notAssignableError{reflect.TypeOf(node)}
+}
+
+func (e *evaluator) updateEach(node parser.Node, current any, variables *variableScope, fn updateFunc) (any, error) {
+	if isDerivedNode(node) {
+		return e.update(node, current, variables, fn)
+	}
+
+	return e.update(node, current, variables, func(v any) (any, error) {
+		return updateArray(v, fn)
+	})
+}
+
+func (e *evaluator) updateField(value any, field string, fn updateFunc) (any, error) {
+	if value == nil {
+		if e.deleting {
+			return nil, nil
+		}
+
+		u, err := fn(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{field: u}, nil
+	}
+
+	m, ok := value.(map[string]any)
+	if !ok {
+		return value, nil
+	}
+
+	v, ok := m[field]
+	if !ok && e.deleting {
+		return value, nil
+	}
+
+	u, err := fn(v)
+	if err != nil {
+		return nil, err
+	}
+
+	r := maps.Clone(m)
+	if u == (deletedValue{}) {
+		delete(r, field)
+	} else {
+		r[field] = u
+	}
+
+	return r, nil
+}
+
+func (e *evaluator) updateFiltered(value any, filter parser.Node, variables *variableScope, fn updateFunc) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	f, err := e.evaluate(filter, value, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isTrue(f) {
+		return value, nil
+	}
+
+	return fn(value)
+}
+
+func (e *evaluator) updateProjected(value any, node parser.Node, variables *variableScope, fn updateFunc) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	selected, err := e.evaluate(node, value, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if selected == nil {
+		return value, nil
+	}
+
+	return e.update(node, value, variables, fn)
+}
+
+func assignableLocation(parent, node parser.Node) error {
+	if isDerivedNode(node) {
+		return &notAssignableError{reflect.TypeOf(parent)}
+	}
+
+	return Assignable(node)
+}
+
+func isDerivedNode(node parser.Node) bool {
+	switch node := node.(type) {
+	case *parser.PipeNode:
+		return isDerivedNode(node.Right)
+	case *parser.FilterNode,
+		*parser.FilterAndProjectNode,
+		*parser.FilterAndProjectCurrentNode,
+		*parser.FilterCurrentNode,
+		*parser.FlattenNode,
+		*parser.FlattenAndProjectNode,
+		*parser.FlattenAndProjectCurrentNode,
+		parser.FlattenCurrentNode,
+		*parser.ObjectValuesNode,
+		parser.ObjectValuesCurrentNode,
+		*parser.ProjectArrayNode,
+		*parser.ProjectArrayCurrentNode,
+		*parser.ProjectObjectNode,
+		*parser.ProjectObjectCurrentNode,
+		*parser.PruneArrayNode,
+		parser.PruneArrayCurrentNode:
+		return true
+	}
+
+	return isSliceNode(node)
+}
+
+func updateArray(value any, fn updateFunc) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return value, nil
+	}
+
+	r := make([]any, 0, len(a))
+	for _, v := range a {
+		u, err := fn(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if u != (deletedValue{}) {
+			r = append(r, u)
+		}
+	}
+
+	return r, nil
+}
+
+func updateFlattened(value any, fn updateFunc) (any, error) {
+	if _, ok := value.([]any); ok {
+		return updateArray(value, func(v any) (any, error) {
+			return updateNonNull(v, fn)
+		})
+	}
+
+	return updateNonNull(value, fn)
+}
+
+func updateIndex(value any, i int, fn updateFunc) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return value, nil
+	}
+
+	if i < 0 {
+		i += len(a)
+	}
+
+	if i < 0 || i >= len(a) {
+		return value, nil
+	}
+
+	u, err := fn(a[i])
+	if err != nil {
+		return nil, err
+	}
+
+	if u == (deletedValue{}) {
+		return slices.Delete(slices.Clone(a), i, i+1), nil
+	}
+
+	r := slices.Clone(a)
+	r[i] = u
+	return r, nil
+}
+
+func updateNonNull(value any, fn updateFunc) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return fn(value)
+}
+
+func updateObject(value any, fn updateFunc) (any, error) {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return value, nil
+	}
+
+	r := make(map[string]any, len(m))
+	for k, v := range m {
+		u, err := fn(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if u != (deletedValue{}) {
+			r[k] = u
+		}
+	}
+
+	return r, nil
+}
+
+func updateSlice(value any, start, stop, step int, fn updateFunc) (any, error) {
+	a, ok := value.([]any)
+	if !ok {
+		return value, nil
+	}
+
+	r := slices.Clone(a)
+	for _, i := range sliceIndexes(len(a), start, stop, step) {
+		u, err := fn(a[i])
+		if err != nil {
+			return nil, err
+		}
+
+		r[i] = u
+	}
+
+	return slices.DeleteFunc(r, func(v any) bool {
+		return v == (deletedValue{})
+	}), nil
+}
@@ -1,8 +1,59 @@
 package evaluator
 
+import "github.com/woodsbury/jmespath/internal/parser"
+
+const maxCallDepth = 1000
+
+func (e *evaluator) callFunction(node *parser.UserFunctionNode, current any, variables *variableScope) (any, error) {
+	function, scope, ok := variables.function(node.Name)
+	if !ok {
+		return nil, &UndefinedVariableError{
+			Variable: node.Name,
+		}
+	}
+
+	if e.depth == maxCallDepth {
+		return nil, &callDepthError{
+			function: node.Name,
+		}
+	}
+
+	args := make(map[string]any, len(function.Parameters))
+	for i, parameter := range function.Parameters {
+		arg, err := e.evaluate(node.Arguments[i], current, variables)
+		if err != nil {
+			return nil, err
+		}
+
+		args[parameter] = arg
+	}
+
+	e.depth++
+	result, err := e.evaluate(function.Body, current, scope.new(args))
+	e.depth--
+	return result, err
+}
+
 type variableScope struct {
 	parent    *variableScope
 	variables map[string]any
+	functions map[string]*parser.FunctionDefinition
+}
+
+func (s *variableScope) function(name string) (*parser.FunctionDefinition, *variableScope, bool) {
+	if s == nil {
+		return nil, nil, false
+	}
+
+	if function, ok := s.functions[name]; ok {
+		return function, s, true
+	}
+
+	if s.parent != nil {
+		return s.parent.function(name)
+	}
+
+	return nil, nil, false
 }
 
 func (s *variableScope) get(variable string) (any, bool) {
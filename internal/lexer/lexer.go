@@ -1,6 +1,9 @@
 package lexer
 
-import "unicode/utf8"
+import (
+	"strings"
+	"unicode/utf8"
+)
 
 type Lexer struct {
 	expression string
@@ -13,6 +16,12 @@ func NewLexer(expression string) Lexer {
 	}
 }
 
+func TemplateParts(template string) ([]string, []string, error) {
+	l := NewLexer(template)
+	literals, expressions, _, err := l.templateParts(2, true)
+	return literals, expressions, err
+}
+
 func (l *Lexer) Next(t *Token) error {
 	if l.position == len(l.expression) {
 		*t = Token{
@@ -31,11 +40,19 @@ func (l *Lexer) Next(t *Token) error {
 			return err
 		}
 
-		if r != '\t' && r != '\n' && r != '\r' && r != ' ' {
-			break
-		}
+		if r == '#' {
+			if i := strings.IndexByte(l.expression[l.position:], '\n'); i >= 0 {
+				l.position += i
+			} else {
+				l.position = len(l.expression)
+			}
+		} else {
+			if r != '\t' && r != '\n' && r != '\r' && r != ' ' {
+				break
+			}
 
-		l.position += sz
+			l.position += sz
+		}
 
 		if l.position == len(l.expression) {
 			*t = Token{
@@ -147,6 +164,19 @@ func (l *Lexer) Next(t *Token) error {
 			return nil
 		}
 
+		if err == nil && nr == '.' {
+			nnr, nnsz, err := l.decodeRune(start + sz + nsz)
+			if err == nil && nnr == '.' {
+				l.position += sz + nsz + nnsz
+				*t = Token{
+					Type:  SpreadToken,
+					Value: l.expression[start:l.position],
+				}
+
+				return nil
+			}
+		}
+
 		l.position += sz
 		*t = Token{
 			Type:  DotToken,
@@ -212,6 +242,16 @@ func (l *Lexer) Next(t *Token) error {
 			return nil
 		}
 
+		if err == nil && nr == '~' {
+			l.position += sz + nsz
+			*t = Token{
+				Type:  MatchToken,
+				Value: l.expression[start:l.position],
+			}
+
+			return nil
+		}
+
 		l.position += sz
 		*t = Token{
 			Type:  AssignToken,
@@ -239,6 +279,17 @@ func (l *Lexer) Next(t *Token) error {
 
 		return nil
 	case '?':
+		nr, nsz, err := l.decodeRune(start + sz)
+		if err == nil && nr == '?' {
+			l.position += sz + nsz
+			*t = Token{
+				Type:  NullCoalesceToken,
+				Value: l.expression[start:l.position],
+			}
+
+			return nil
+		}
+
 		l.position += sz
 		*t = Token{
 			Type:  IfToken,
@@ -381,6 +432,16 @@ func (l *Lexer) Next(t *Token) error {
 			return nil
 		}
 
+		if err == nil && nr == '~' {
+			l.position += sz + nsz
+			*t = Token{
+				Type:  NotMatchToken,
+				Value: l.expression[start:l.position],
+			}
+
+			return nil
+		}
+
 		l.position += sz
 		*t = Token{
 			Type:  NotToken,
@@ -548,8 +609,104 @@ func (l *Lexer) unquotedIdentifier(t *Token, start, next int) error {
 	}
 }
 
+func (l *Lexer) templateExpressionEnd(next int) (int, error) {
+	position := l.position
+	defer func() {
+		l.position = position
+	}()
+
+	l.position = next
+
+	var depth int
+	var t Token
+	for {
+		if err := l.Next(&t); err != nil {
+			return 0, err
+		}
+
+		switch t.Type {
+		case EndToken:
+			return 0, errUnexpectedEndOfExpression
+		case OpenBraceToken:
+			depth++
+		case CloseBraceToken:
+			if depth == 0 {
+				return l.position, nil
+			}
+
+			depth--
+		}
+	}
+}
+
+func (l *Lexer) templateLiteral(t *Token, start, next int) error {
+	_, _, next, err := l.templateParts(next, false)
+	if err != nil {
+		return err
+	}
+
+	l.position = next
+	*t = Token{
+		Type:  TemplateLiteralToken,
+		Value: l.expression[start:next],
+	}
+
+	return nil
+}
+
+func (l *Lexer) templateParts(next int, collect bool) ([]string, []string, int, error) {
+	var literals, expressions []string
+	literal := next
+	for {
+		r, sz, err := l.decodeRune(next)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		next += sz
+
+		switch r {
+		case '`':
+			if collect {
+				literals = append(literals, l.expression[literal:next-sz])
+			}
+
+			return literals, expressions, next, nil
+		case '\\':
+			_, sz, err := l.decodeRune(next)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			next += sz
+		case '$':
+			r, sz, err := l.decodeRune(next)
+			if err != nil || r != '{' {
+				continue
+			}
+
+			end, err := l.templateExpressionEnd(next + sz)
+			if err != nil {
+				return nil, nil, 0, err
+			}
+
+			if collect {
+				literals = append(literals, l.expression[literal:next-1])
+				expressions = append(expressions, l.expression[next+sz:end-1])
+			}
+
+			next = end
+			literal = end
+		}
+	}
+}
+
 func (l *Lexer) variable(t *Token, start, next int) error {
 	r, sz, err := l.decodeRune(next)
+	if err == nil && r == '`' {
+		return l.templateLiteral(t, start, next+sz)
+	}
+
 	if err != nil || !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r == '_') {
 		l.position = next
 		*t = Token{
@@ -4,6 +4,9 @@ import "testing"
 
 func FuzzLexer(f *testing.F) {
 	f.Add("a[].b[?c == 'X'] | {x: join(', ', @)}")
+	f.Add("$`Hello ${name} \\${x}`")
+	f.Add("{...@, [name]: [...a, ...b]}")
+	f.Add("a # comment\n| b")
 
 	f.Fuzz(func(t *testing.T, expression string) {
 		t.Parallel()
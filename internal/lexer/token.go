@@ -33,13 +33,18 @@ const (
 	LessToken
 	LessOrEqualToken
 	LetToken
+	MatchToken
 	ModuloToken
 	MultiplyToken
 	NotToken
 	NotEqualToken
+	NotInToken
+	NotMatchToken
+	NullCoalesceToken
 	ObjectWildcardToken
 	OrToken
 	PipeToken
+	SpreadToken
 	SubtractToken
 
 	CurrentToken
@@ -50,6 +55,7 @@ const (
 	RootToken
 	UnquotedIdentifierToken
 	StringLiteralToken
+	TemplateLiteralToken
 	VariableToken
 )
 
@@ -19,6 +19,19 @@ func (err *InvalidFunctionCallError) Error() string {
 	return "invalid call to function " + strconv.Quote(err.Function)
 }
 
+type InvalidRegexpError struct {
+	Pattern string
+	err     error
+}
+
+func (err *InvalidRegexpError) Error() string {
+	return "invalid regular expression " + strconv.Quote(err.Pattern) + ": " + err.err.Error()
+}
+
+func (err *InvalidRegexpError) Unwrap() error {
+	return err.err
+}
+
 type InvalidSliceStepError struct{}
 
 func (err *InvalidSliceStepError) Error() string {
@@ -33,6 +46,14 @@ func (err *UnknownFunctionError) Error() string {
 	return "call to unknown function " + strconv.Quote(err.Function)
 }
 
+type invalidEscapeError struct {
+	s string
+}
+
+func (err *invalidEscapeError) Error() string {
+	return "invalid escape sequence " + strconv.Quote(err.s)
+}
+
 type invalidIndexError struct {
 	s string
 }
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"encoding/json"
+	"regexp"
 	"strconv"
 )
 
@@ -21,6 +22,19 @@ func (n *AbsNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
+type AddDurationNode struct {
+	Arguments [2]Node
+}
+
+func (n *AddDurationNode) String() string {
+	return "AddDuration"
+}
+
+func (n *AddDurationNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type AddNode struct {
 	Left  Node
 	Right Node
@@ -35,6 +49,19 @@ func (n *AddNode) Walk(v Visitor) {
 	v.Visit(n.Right)
 }
 
+type AllNode struct {
+	Arguments [2]Node
+}
+
+func (n *AllNode) String() string {
+	return "All"
+}
+
+func (n *AllNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type AndNode struct {
 	Left  Node
 	Right Node
@@ -49,6 +76,19 @@ func (n *AndNode) Walk(v Visitor) {
 	v.Visit(n.Right)
 }
 
+type AnyNode struct {
+	Arguments [2]Node
+}
+
+func (n *AnyNode) String() string {
+	return "Any"
+}
+
+func (n *AnyNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type AssertNumberNode struct {
 	Child Node
 }
@@ -73,6 +113,67 @@ func (n *AvgNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
+type AvgByNode struct {
+	Arguments [2]Node
+}
+
+func (n *AvgByNode) String() string {
+	return "AvgBy"
+}
+
+func (n *AvgByNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type Base64DecodeNode struct {
+	Argument Node
+}
+
+func (n *Base64DecodeNode) String() string {
+	return "Base64Decode"
+}
+
+func (n *Base64DecodeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type Base64EncodeNode struct {
+	Argument Node
+}
+
+func (n *Base64EncodeNode) String() string {
+	return "Base64Encode"
+}
+
+func (n *Base64EncodeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type Base64URLDecodeNode struct {
+	Argument Node
+}
+
+func (n *Base64URLDecodeNode) String() string {
+	return "Base64URLDecode"
+}
+
+func (n *Base64URLDecodeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type Base64URLEncodeNode struct {
+	Argument Node
+}
+
+func (n *Base64URLEncodeNode) String() string {
+	return "Base64URLEncode"
+}
+
+func (n *Base64URLEncodeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
 type BoolNode struct {
 	Value bool
 }
@@ -81,6 +182,42 @@ func (n BoolNode) String() string {
 	return "Bool: " + strconv.FormatBool(n.Value)
 }
 
+type CRC32Node struct {
+	Argument Node
+}
+
+func (n *CRC32Node) String() string {
+	return "CRC32"
+}
+
+func (n *CRC32Node) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type CamelCaseNode struct {
+	Argument Node
+}
+
+func (n *CamelCaseNode) String() string {
+	return "CamelCase"
+}
+
+func (n *CamelCaseNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type CasefoldNode struct {
+	Argument Node
+}
+
+func (n *CasefoldNode) String() string {
+	return "Casefold"
+}
+
+func (n *CasefoldNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
 type CeilNode struct {
 	Argument Node
 }
@@ -93,6 +230,45 @@ func (n *CeilNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
+type ChunkNode struct {
+	Arguments [2]Node
+}
+
+func (n *ChunkNode) String() string {
+	return "Chunk"
+}
+
+func (n *ChunkNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type ClampNode struct {
+	Arguments [3]Node
+}
+
+func (n *ClampNode) String() string {
+	return "Clamp"
+}
+
+func (n *ClampNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type CompactNode struct {
+	Argument Node
+}
+
+func (n *CompactNode) String() string {
+	return "Compact"
+}
+
+func (n *CompactNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
 type ContainsNode struct {
 	Arguments [2]Node
 }
@@ -106,6 +282,45 @@ func (n *ContainsNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[1])
 }
 
+type CountNode struct {
+	Arguments [2]Node
+}
+
+func (n *CountNode) String() string {
+	return "Count"
+}
+
+func (n *CountNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type CountByNode struct {
+	Arguments [2]Node
+}
+
+func (n *CountByNode) String() string {
+	return "CountBy"
+}
+
+func (n *CountByNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type CumsumNode struct {
+	Arguments [2]Node
+}
+
+func (n *CumsumNode) String() string {
+	return "Cumsum"
+}
+
+func (n *CumsumNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type CurrentNode struct{}
 
 func (n CurrentNode) String() string {
@@ -114,6 +329,7 @@ func (n CurrentNode) String() string {
 
 type DefineVariables struct {
 	Variables map[string]Node
+	Functions map[string]*FunctionDefinition
 	Child     Node
 }
 
@@ -126,9 +342,52 @@ func (n *DefineVariables) Walk(v Visitor) {
 		v.Visit(variable)
 	}
 
+	for _, function := range n.Functions {
+		v.Visit(function.Body)
+	}
+
 	v.Visit(n.Child)
 }
 
+type DenseRankNode struct {
+	Arguments [2]Node
+}
+
+func (n *DenseRankNode) String() string {
+	return "DenseRank"
+}
+
+func (n *DenseRankNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type DiffNode struct {
+	Arguments [2]Node
+}
+
+func (n *DiffNode) String() string {
+	return "Diff"
+}
+
+func (n *DiffNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type DifferenceNode struct {
+	Arguments [2]Node
+}
+
+func (n *DifferenceNode) String() string {
+	return "Difference"
+}
+
+func (n *DifferenceNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type DivideNode struct {
 	Left  Node
 	Right Node
@@ -143,6 +402,19 @@ func (n *DivideNode) Walk(v Visitor) {
 	v.Visit(n.Right)
 }
 
+type DropNode struct {
+	Arguments [2]Node
+}
+
+func (n *DropNode) String() string {
+	return "Drop"
+}
+
+func (n *DropNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type EndsWithNode struct {
 	Arguments [2]Node
 }
@@ -170,6 +442,31 @@ func (n *EqualNode) Walk(v Visitor) {
 	v.Visit(n.Right)
 }
 
+type EqualsIgnoreCaseNode struct {
+	Arguments [2]Node
+}
+
+func (n *EqualsIgnoreCaseNode) String() string {
+	return "EqualsIgnoreCase"
+}
+
+func (n *EqualsIgnoreCaseNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type ExpNode struct {
+	Argument Node
+}
+
+func (n *ExpNode) String() string {
+	return "Exp"
+}
+
+func (n *ExpNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
 type FieldNode struct {
 	Value string
 }
@@ -222,6 +519,19 @@ func (n *FilterAndProjectCurrentNode) Walk(v Visitor) {
 	v.Visit(n.Child)
 }
 
+type FilterArrayNode struct {
+	Arguments [2]Node
+}
+
+func (n *FilterArrayNode) String() string {
+	return "FilterArray"
+}
+
+func (n *FilterArrayNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type FilterCurrentNode struct {
 	Filter Node
 }
@@ -234,6 +544,19 @@ func (n *FilterCurrentNode) Walk(v Visitor) {
 	v.Visit(n.Filter)
 }
 
+type FindNode struct {
+	Arguments [2]Node
+}
+
+func (n *FindNode) String() string {
+	return "Find"
+}
+
+func (n *FindNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type FindFirstNode struct {
 	Arguments [2]Node
 }
@@ -276,6 +599,19 @@ func (n *FindFirstFromNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[2])
 }
 
+type FindIndexNode struct {
+	Arguments [2]Node
+}
+
+func (n *FindIndexNode) String() string {
+	return "FindIndex"
+}
+
+func (n *FindIndexNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type FindLastNode struct {
 	Arguments [2]Node
 }
@@ -362,675 +698,1679 @@ func (n FlattenCurrentNode) String() string {
 	return "FlattenCurrent"
 }
 
-type FloorNode struct {
+type FlattenDeepNode struct {
 	Argument Node
 }
 
-func (n *FloorNode) String() string {
-	return "Floor"
+func (n *FlattenDeepNode) String() string {
+	return "FlattenDeep"
 }
 
-func (n *FloorNode) Walk(v Visitor) {
+func (n *FlattenDeepNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type FromItemsNode struct {
-	Argument Node
+type FlattenDeepDepthNode struct {
+	Arguments [2]Node
 }
 
-func (n *FromItemsNode) String() string {
-	return "FromItems"
+func (n *FlattenDeepDepthNode) String() string {
+	return "FlattenDeepDepth"
 }
 
-func (n *FromItemsNode) Walk(v Visitor) {
-	v.Visit(n.Argument)
+func (n *FlattenDeepDepthNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-type GreaterNode struct {
-	Left  Node
-	Right Node
+type FloorNode struct {
+	Argument Node
 }
 
-func (n *GreaterNode) String() string {
-	return "Greater"
+func (n *FloorNode) String() string {
+	return "Floor"
 }
 
-func (n *GreaterNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
+func (n *FloorNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type GreaterOrEqualNode struct {
-	Left  Node
-	Right Node
+type FormatNode struct {
+	Arguments []Node
 }
 
-func (n *GreaterOrEqualNode) String() string {
-	return "GreaterOrEqual"
+func (n *FormatNode) String() string {
+	return "Format"
 }
 
-func (n *GreaterOrEqualNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
+func (n *FormatNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
 }
 
-type GroupByNode struct {
+type FormatTimeNode struct {
 	Arguments [2]Node
 }
 
-func (n *GroupByNode) String() string {
-	return "GroupBy"
+func (n *FormatTimeNode) String() string {
+	return "FormatTime"
 }
 
-func (n *GroupByNode) Walk(v Visitor) {
+func (n *FormatTimeNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
 }
 
-type IfNode struct {
-	Condition Node
-	Then      Node
-	Else      Node
+type FromEpochNode struct {
+	Argument Node
 }
 
-func (n *IfNode) String() string {
-	return "If"
+func (n *FromEpochNode) String() string {
+	return "FromEpoch"
 }
 
-func (n *IfNode) Walk(v Visitor) {
-	v.Visit(n.Condition)
-	v.Visit(n.Then)
-	v.Visit(n.Else)
+func (n *FromEpochNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type IndexNode struct {
-	Child Node
-	Value int
+type FromEpochMillisNode struct {
+	Argument Node
 }
 
-func (n *IndexNode) String() string {
-	return "Index: " + strconv.FormatInt(int64(n.Value), 10)
+func (n *FromEpochMillisNode) String() string {
+	return "FromEpochMillis"
 }
 
-func (n *IndexNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+func (n *FromEpochMillisNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type IndexCurrentNode struct {
-	Value int
+type FromItemsNode struct {
+	Argument Node
 }
 
-func (n *IndexCurrentNode) String() string {
-	return "IndexCurrent: " + strconv.FormatInt(int64(n.Value), 10)
+func (n *FromItemsNode) String() string {
+	return "FromItems"
 }
 
-type IntegerDivideNode struct {
-	Left  Node
-	Right Node
+func (n *FromItemsNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *IntegerDivideNode) String() string {
-	return "IntegerDivide"
+type FromJSONNode struct {
+	Argument Node
 }
 
-func (n *IntegerDivideNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
+func (n *FromJSONNode) String() string {
+	return "FromJSON"
 }
 
-type ItemsNode struct {
-	Argument Node
+func (n *FromJSONNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *ItemsNode) String() string {
-	return "Items"
+type FunctionDefinition struct {
+	Parameters []string
+	Body       Node
 }
 
-func (n *ItemsNode) Walk(v Visitor) {
-	v.Visit(n.Argument)
+type GetNode struct {
+	Arguments [2]Node
 }
 
-type JoinNode struct {
-	Arguments [2]Node
+func (n *GetNode) String() string {
+	return "Get"
 }
 
-func (n *JoinNode) String() string {
-	return "Join"
+func (n *GetNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-func (n *JoinNode) Walk(v Visitor) {
+type GetDefaultNode struct {
+	Arguments [3]Node
+}
+
+func (n *GetDefaultNode) String() string {
+	return "GetDefault"
+}
+
+func (n *GetDefaultNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
 }
 
-type KeysNode struct {
+type GraphemeLengthNode struct {
 	Argument Node
 }
 
-func (n *KeysNode) String() string {
-	return "Keys"
+func (n *GraphemeLengthNode) String() string {
+	return "GraphemeLength"
 }
 
-func (n *KeysNode) Walk(v Visitor) {
+func (n *GraphemeLengthNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type LengthNode struct {
+type GraphemeReverseNode struct {
 	Argument Node
 }
 
-func (n *LengthNode) String() string {
-	return "Length"
+func (n *GraphemeReverseNode) String() string {
+	return "GraphemeReverse"
 }
 
-func (n *LengthNode) Walk(v Visitor) {
+func (n *GraphemeReverseNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type LessNode struct {
+type GraphemeSliceNode struct {
+	Arguments [2]Node
+}
+
+func (n *GraphemeSliceNode) String() string {
+	return "GraphemeSlice"
+}
+
+func (n *GraphemeSliceNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type GraphemeSliceStopNode struct {
+	Arguments [3]Node
+}
+
+func (n *GraphemeSliceStopNode) String() string {
+	return "GraphemeSliceStop"
+}
+
+func (n *GraphemeSliceStopNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type GreaterNode struct {
 	Left  Node
 	Right Node
 }
 
-func (n *LessNode) String() string {
-	return "Less"
+func (n *GreaterNode) String() string {
+	return "Greater"
 }
 
-func (n *LessNode) Walk(v Visitor) {
+func (n *GreaterNode) Walk(v Visitor) {
 	v.Visit(n.Left)
 	v.Visit(n.Right)
 }
 
-type LessOrEqualNode struct {
+type GreaterOrEqualNode struct {
 	Left  Node
 	Right Node
 }
 
-func (n *LessOrEqualNode) String() string {
-	return "LessOrEqual"
+func (n *GreaterOrEqualNode) String() string {
+	return "GreaterOrEqual"
 }
 
-func (n *LessOrEqualNode) Walk(v Visitor) {
+func (n *GreaterOrEqualNode) Walk(v Visitor) {
 	v.Visit(n.Left)
 	v.Visit(n.Right)
 }
 
-type LowerNode struct {
-	Argument Node
+type GreatestNode struct {
+	Arguments []Node
 }
 
-func (n *LowerNode) String() string {
-	return "Lower"
+func (n *GreatestNode) String() string {
+	return "Greatest"
 }
 
-func (n *LowerNode) Walk(v Visitor) {
-	v.Visit(n.Argument)
+func (n *GreatestNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
 }
 
-type MapNode struct {
+type GroupByNode struct {
 	Arguments [2]Node
 }
 
-func (n *MapNode) String() string {
-	return "Map"
+func (n *GroupByNode) String() string {
+	return "GroupBy"
 }
 
-func (n *MapNode) Walk(v Visitor) {
+func (n *GroupByNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
 }
 
-type MaxNode struct {
+type HTMLEscapeNode struct {
 	Argument Node
 }
 
-func (n *MaxNode) String() string {
-	return "Max"
+func (n *HTMLEscapeNode) String() string {
+	return "HTMLEscape"
 }
 
-func (n *MaxNode) Walk(v Visitor) {
+func (n *HTMLEscapeNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type MaxByNode struct {
+type HasNode struct {
 	Arguments [2]Node
 }
 
-func (n *MaxByNode) String() string {
-	return "MaxBy"
+func (n *HasNode) String() string {
+	return "Has"
 }
 
-func (n *MaxByNode) Walk(v Visitor) {
+func (n *HasNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
 }
 
-type MergeNode struct {
-	Arguments []Node
+type HexDecodeNode struct {
+	Argument Node
 }
 
-func (n *MergeNode) String() string {
-	return "Merge"
+func (n *HexDecodeNode) String() string {
+	return "HexDecode"
 }
 
-func (n *MergeNode) Walk(v Visitor) {
-	for _, arg := range n.Arguments {
-		v.Visit(arg)
-	}
+func (n *HexDecodeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type MinNode struct {
+type HexEncodeNode struct {
 	Argument Node
 }
 
-func (n *MinNode) String() string {
-	return "Min"
+func (n *HexEncodeNode) String() string {
+	return "HexEncode"
 }
 
-func (n *MinNode) Walk(v Visitor) {
+func (n *HexEncodeNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type MinByNode struct {
-	Arguments [2]Node
+type IfNode struct {
+	Condition Node
+	Then      Node
+	Else      Node
 }
 
-func (n *MinByNode) String() string {
-	return "MinBy"
+func (n *IfNode) String() string {
+	return "If"
 }
 
-func (n *MinByNode) Walk(v Visitor) {
-	v.Visit(n.Arguments[0])
-	v.Visit(n.Arguments[1])
+func (n *IfNode) Walk(v Visitor) {
+	v.Visit(n.Condition)
+	v.Visit(n.Then)
+	v.Visit(n.Else)
 }
 
-type ModuloNode struct {
+type InNode struct {
 	Left  Node
 	Right Node
 }
 
-func (n *ModuloNode) String() string {
-	return "Modulo"
+func (n *InNode) String() string {
+	return "In"
 }
 
-func (n *ModuloNode) Walk(v Visitor) {
+func (n *InNode) Walk(v Visitor) {
 	v.Visit(n.Left)
 	v.Visit(n.Right)
 }
 
-type MultiplyNode struct {
-	Left  Node
-	Right Node
+type IndentNode struct {
+	Arguments [2]Node
 }
 
-func (n *MultiplyNode) String() string {
-	return "Multiply"
+func (n *IndentNode) String() string {
+	return "Indent"
 }
 
-func (n *MultiplyNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
+func (n *IndentNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-type NegateNode struct {
+type IndexNode struct {
 	Child Node
+	Value int
 }
 
-func (n *NegateNode) String() string {
-	return "Negate"
+func (n *IndexNode) String() string {
+	return "Index: " + strconv.FormatInt(int64(n.Value), 10)
 }
 
-func (n *NegateNode) Walk(v Visitor) {
+func (n *IndexNode) Walk(v Visitor) {
 	v.Visit(n.Child)
 }
 
-type NotNode struct {
-	Child Node
+type IndexCurrentNode struct {
+	Value int
 }
 
-func (n *NotNode) String() string {
-	return "Not"
+func (n *IndexCurrentNode) String() string {
+	return "IndexCurrent: " + strconv.FormatInt(int64(n.Value), 10)
 }
 
-func (n *NotNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+type IndexOfNode struct {
+	Arguments [2]Node
 }
 
-type NotEqualNode struct {
+func (n *IndexOfNode) String() string {
+	return "IndexOf"
+}
+
+func (n *IndexOfNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type InsertNode struct {
+	Arguments [3]Node
+}
+
+func (n *InsertNode) String() string {
+	return "Insert"
+}
+
+func (n *InsertNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type IntegerDivideNode struct {
 	Left  Node
 	Right Node
 }
 
-func (n *NotEqualNode) String() string {
-	return "NotEqual"
+func (n *IntegerDivideNode) String() string {
+	return "IntegerDivide"
 }
 
-func (n *NotEqualNode) Walk(v Visitor) {
+func (n *IntegerDivideNode) Walk(v Visitor) {
 	v.Visit(n.Left)
 	v.Visit(n.Right)
 }
 
-type NotNullNode struct {
+type IntersectionNode struct {
 	Arguments []Node
 }
 
-func (n *NotNullNode) String() string {
-	return "NotNull"
+func (n *IntersectionNode) String() string {
+	return "Intersection"
 }
 
-func (n *NotNullNode) Walk(v Visitor) {
+func (n *IntersectionNode) Walk(v Visitor) {
 	for _, arg := range n.Arguments {
 		v.Visit(arg)
 	}
 }
 
-type NotNullValueNode struct {
+type IsArrayNode struct {
 	Argument Node
-	Value    any
 }
 
-func (n *NotNullValueNode) String() string {
-	switch value := n.Value.(type) {
-	case json.Number:
-		return "NotNullValue: " + value.String()
-	case string:
-		return "NotNullValue: " + value
-	default:
-		return "NotNullValue"
-	}
+func (n *IsArrayNode) String() string {
+	return "IsArray"
 }
 
-type NullNode struct{}
+func (n *IsArrayNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
 
-func (n NullNode) String() string {
-	return "Null"
+type IsBooleanNode struct {
+	Argument Node
 }
 
-type ObjectValuesNode struct {
-	Child Node
+func (n *IsBooleanNode) String() string {
+	return "IsBoolean"
 }
 
-func (n *ObjectValuesNode) String() string {
-	return "ObjectValues"
+func (n *IsBooleanNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *ObjectValuesNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+type IsIntegerNode struct {
+	Argument Node
 }
 
-type ObjectValuesCurrentNode struct{}
+func (n *IsIntegerNode) String() string {
+	return "IsInteger"
+}
 
-func (n ObjectValuesCurrentNode) String() string {
-	return "ObjectValuesCurrent"
+func (n *IsIntegerNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type OrNode struct {
-	Left  Node
-	Right Node
+type IsNullNode struct {
+	Argument Node
 }
 
-func (n *OrNode) String() string {
-	return "Or"
+func (n *IsNullNode) String() string {
+	return "IsNull"
 }
 
-func (n *OrNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
+func (n *IsNullNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type PadLeftNode struct {
-	Arguments [3]Node
+type IsNumberNode struct {
+	Argument Node
 }
 
-func (n *PadLeftNode) String() string {
-	return "PadLeft"
+func (n *IsNumberNode) String() string {
+	return "IsNumber"
 }
 
-func (n *PadLeftNode) Walk(v Visitor) {
-	v.Visit(n.Arguments[0])
-	v.Visit(n.Arguments[1])
-	v.Visit(n.Arguments[2])
+func (n *IsNumberNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type PadRightNode struct {
-	Arguments [3]Node
+type IsObjectNode struct {
+	Argument Node
 }
 
-func (n *PadRightNode) String() string {
-	return "PadRight"
+func (n *IsObjectNode) String() string {
+	return "IsObject"
 }
 
-func (n *PadRightNode) Walk(v Visitor) {
-	v.Visit(n.Arguments[0])
-	v.Visit(n.Arguments[1])
-	v.Visit(n.Arguments[2])
+func (n *IsObjectNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type PadSpaceLeftNode struct {
-	Arguments [2]Node
+type IsStringNode struct {
+	Argument Node
 }
 
-func (n *PadSpaceLeftNode) String() string {
-	return "PadSpaceLeft"
+func (n *IsStringNode) String() string {
+	return "IsString"
 }
 
-func (n *PadSpaceLeftNode) Walk(v Visitor) {
-	v.Visit(n.Arguments[0])
-	v.Visit(n.Arguments[1])
+func (n *IsStringNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type PadSpaceRightNode struct {
+type ItemsNode struct {
+	Argument Node
+}
+
+func (n *ItemsNode) String() string {
+	return "Items"
+}
+
+func (n *ItemsNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type JoinNode struct {
 	Arguments [2]Node
 }
 
-func (n *PadSpaceRightNode) String() string {
-	return "PadSpaceRight"
+func (n *JoinNode) String() string {
+	return "Join"
 }
 
-func (n *PadSpaceRightNode) Walk(v Visitor) {
+func (n *JoinNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
 }
 
-type PipeNode struct {
-	Left  Node
-	Right Node
+type KebabCaseNode struct {
+	Argument Node
 }
 
-func (n *PipeNode) String() string {
-	return "Pipe"
+func (n *KebabCaseNode) String() string {
+	return "KebabCase"
 }
 
-func (n *PipeNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
+func (n *KebabCaseNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type PipeFieldNode struct {
-	Left  Node
-	Right string
+type KeysNode struct {
+	Argument Node
 }
 
-func (n *PipeFieldNode) String() string {
-	return "PipeField: " + n.Right
+func (n *KeysNode) String() string {
+	return "Keys"
 }
 
-func (n *PipeFieldNode) Walk(v Visitor) {
-	v.Visit(n.Left)
+func (n *KeysNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type ProjectArrayNode struct {
-	Left  Node
-	Right Node
+type LagNode struct {
+	Arguments [2]Node
 }
 
-func (n *ProjectArrayNode) String() string {
-	return "ProjectArray"
+func (n *LagNode) String() string {
+	return "Lag"
 }
 
-func (n *ProjectArrayNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
+func (n *LagNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-type ProjectArrayCurrentNode struct {
-	Child Node
+type LeadNode struct {
+	Arguments [2]Node
 }
 
-func (n *ProjectArrayCurrentNode) String() string {
-	return "ProjectArrayCurrent"
+func (n *LeadNode) String() string {
+	return "Lead"
 }
 
-func (n *ProjectArrayCurrentNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+func (n *LeadNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-type ProjectObjectNode struct {
+type LeastNode struct {
+	Arguments []Node
+}
+
+func (n *LeastNode) String() string {
+	return "Least"
+}
+
+func (n *LeastNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
+}
+
+type LengthNode struct {
+	Argument Node
+}
+
+func (n *LengthNode) String() string {
+	return "Length"
+}
+
+func (n *LengthNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type LessNode struct {
 	Left  Node
 	Right Node
 }
 
-func (n *ProjectObjectNode) String() string {
-	return "ProjectObject"
+func (n *LessNode) String() string {
+	return "Less"
 }
 
-func (n *ProjectObjectNode) Walk(v Visitor) {
+func (n *LessNode) Walk(v Visitor) {
 	v.Visit(n.Left)
 	v.Visit(n.Right)
 }
 
-type ProjectObjectCurrentNode struct {
-	Child Node
+type LessOrEqualNode struct {
+	Left  Node
+	Right Node
 }
 
-func (n *ProjectObjectCurrentNode) String() string {
-	return "ProjectObjectCurrent"
+func (n *LessOrEqualNode) String() string {
+	return "LessOrEqual"
 }
 
-func (n *ProjectObjectCurrentNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+func (n *LessOrEqualNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
 }
 
-type PruneArrayNode struct {
-	Child Node
+type LinesNode struct {
+	Argument Node
 }
 
-func (n *PruneArrayNode) String() string {
-	return "PruneArray"
+func (n *LinesNode) String() string {
+	return "Lines"
 }
 
-func (n *PruneArrayNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+func (n *LinesNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type PruneArrayCurrentNode struct{}
+type LnNode struct {
+	Argument Node
+}
 
-func (n PruneArrayCurrentNode) String() string {
-	return "PruneArrayCurrent"
+func (n *LnNode) String() string {
+	return "Ln"
 }
 
-type ReplaceNode struct {
-	Arguments [3]Node
+func (n *LnNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *ReplaceNode) String() string {
-	return "Replace"
+type LogNode struct {
+	Argument Node
 }
 
-func (n *ReplaceNode) Walk(v Visitor) {
-	v.Visit(n.Arguments[0])
-	v.Visit(n.Arguments[1])
-	v.Visit(n.Arguments[2])
+func (n *LogNode) String() string {
+	return "Log"
 }
 
-type ReplaceCountNode struct {
-	Arguments [4]Node
+func (n *LogNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *ReplaceCountNode) String() string {
-	return "ReplaceCount"
+type LogBaseNode struct {
+	Arguments [2]Node
 }
 
-func (n *ReplaceCountNode) Walk(v Visitor) {
+func (n *LogBaseNode) String() string {
+	return "LogBase"
+}
+
+func (n *LogBaseNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
-	v.Visit(n.Arguments[2])
-	v.Visit(n.Arguments[3])
 }
 
-type ReverseNode struct {
+type LowerNode struct {
 	Argument Node
 }
 
-func (n *ReverseNode) String() string {
-	return "Reverse"
+func (n *LowerNode) String() string {
+	return "Lower"
 }
 
-func (n *ReverseNode) Walk(v Visitor) {
+func (n *LowerNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type RootNode struct{}
-
-func (n RootNode) String() string {
-	return "Root"
+type MD5Node struct {
+	Argument Node
 }
 
-type SelectArrayNode struct {
-	Child  Node
-	Fields []Node
+func (n *MD5Node) String() string {
+	return "MD5"
 }
 
-func (n *SelectArrayNode) String() string {
-	return "SelectArray"
+func (n *MD5Node) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *SelectArrayNode) Walk(v Visitor) {
-	v.Visit(n.Child)
-
-	for _, field := range n.Fields {
-		v.Visit(field)
-	}
+type MapNode struct {
+	Arguments [2]Node
 }
 
-type SelectArrayCurrentNode struct {
-	Fields []Node
+func (n *MapNode) String() string {
+	return "Map"
 }
 
-func (n *SelectArrayCurrentNode) String() string {
-	return "SelectArrayCurrent"
+func (n *MapNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-func (n *SelectArrayCurrentNode) Walk(v Visitor) {
-	for _, field := range n.Fields {
-		v.Visit(field)
-	}
+type MapKeysNode struct {
+	Arguments [2]Node
 }
 
-type SelectArraySingleNode struct {
-	Child Node
-	Field Node
+func (n *MapKeysNode) String() string {
+	return "MapKeys"
 }
 
-func (n *SelectArraySingleNode) String() string {
-	return "SelectArraySingle"
+func (n *MapKeysNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-func (n *SelectArraySingleNode) Walk(v Visitor) {
-	v.Visit(n.Child)
-	v.Visit(n.Field)
+type MatchNode struct {
+	Left  Node
+	Right Node
 }
 
-type SelectArraySingleCurrentNode struct {
-	Field Node
+func (n *MatchNode) String() string {
+	return "Match"
 }
 
-func (n *SelectArraySingleCurrentNode) String() string {
-	return "SelectArraySingleCurrent"
+func (n *MatchNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
 }
 
-func (n *SelectArraySingleCurrentNode) Walk(v Visitor) {
-	v.Visit(n.Field)
+type MaxNode struct {
+	Argument Node
+}
+
+func (n *MaxNode) String() string {
+	return "Max"
+}
+
+func (n *MaxNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type MaxByNode struct {
+	Arguments [2]Node
+}
+
+func (n *MaxByNode) String() string {
+	return "MaxBy"
+}
+
+func (n *MaxByNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type MedianNode struct {
+	Argument Node
+}
+
+func (n *MedianNode) String() string {
+	return "Median"
+}
+
+func (n *MedianNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type MergeNode struct {
+	Arguments []Node
+}
+
+func (n *MergeNode) String() string {
+	return "Merge"
+}
+
+func (n *MergeNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
+}
+
+type MergeDeepNode struct {
+	Arguments []Node
+}
+
+func (n *MergeDeepNode) String() string {
+	return "MergeDeep"
+}
+
+func (n *MergeDeepNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
+}
+
+type MinNode struct {
+	Argument Node
+}
+
+func (n *MinNode) String() string {
+	return "Min"
+}
+
+func (n *MinNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type MinByNode struct {
+	Arguments [2]Node
+}
+
+func (n *MinByNode) String() string {
+	return "MinBy"
+}
+
+func (n *MinByNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type ModeNode struct {
+	Argument Node
+}
+
+func (n *ModeNode) String() string {
+	return "Mode"
+}
+
+func (n *ModeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type ModuloNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *ModuloNode) String() string {
+	return "Modulo"
+}
+
+func (n *ModuloNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type MultiplyNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *MultiplyNode) String() string {
+	return "Multiply"
+}
+
+func (n *MultiplyNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type NegateNode struct {
+	Child Node
+}
+
+func (n *NegateNode) String() string {
+	return "Negate"
+}
+
+func (n *NegateNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type NormalizeNode struct {
+	Arguments [2]Node
+}
+
+func (n *NormalizeNode) String() string {
+	return "Normalize"
+}
+
+func (n *NormalizeNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type NotNode struct {
+	Child Node
+}
+
+func (n *NotNode) String() string {
+	return "Not"
+}
+
+func (n *NotNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type NotEqualNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *NotEqualNode) String() string {
+	return "NotEqual"
+}
+
+func (n *NotEqualNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type NotMatchNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *NotMatchNode) String() string {
+	return "NotMatch"
+}
+
+func (n *NotMatchNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type NotNullNode struct {
+	Arguments []Node
+}
+
+func (n *NotNullNode) String() string {
+	return "NotNull"
+}
+
+func (n *NotNullNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
+}
+
+type NotNullValueNode struct {
+	Argument Node
+	Value    any
+}
+
+func (n *NotNullValueNode) String() string {
+	switch value := n.Value.(type) {
+	case json.Number:
+		return "NotNullValue: " + value.String()
+	case string:
+		return "NotNullValue: " + value
+	default:
+		return "NotNullValue"
+	}
+}
+
+type NowNode struct{}
+
+func (n NowNode) String() string {
+	return "Now"
+}
+
+type NullCoalesceNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *NullCoalesceNode) String() string {
+	return "NullCoalesce"
+}
+
+func (n *NullCoalesceNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type NullNode struct{}
+
+func (n NullNode) String() string {
+	return "Null"
+}
+
+type ObjectValuesNode struct {
+	Child Node
+}
+
+func (n *ObjectValuesNode) String() string {
+	return "ObjectValues"
+}
+
+func (n *ObjectValuesNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type ObjectValuesCurrentNode struct{}
+
+func (n ObjectValuesCurrentNode) String() string {
+	return "ObjectValuesCurrent"
+}
+
+type OmitNode struct {
+	Arguments [2]Node
+}
+
+func (n *OmitNode) String() string {
+	return "Omit"
+}
+
+func (n *OmitNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type OrNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *OrNode) String() string {
+	return "Or"
+}
+
+func (n *OrNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type PadLeftNode struct {
+	Arguments [3]Node
+}
+
+func (n *PadLeftNode) String() string {
+	return "PadLeft"
+}
+
+func (n *PadLeftNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type PadRightNode struct {
+	Arguments [3]Node
+}
+
+func (n *PadRightNode) String() string {
+	return "PadRight"
+}
+
+func (n *PadRightNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type PadSpaceLeftNode struct {
+	Arguments [2]Node
+}
+
+func (n *PadSpaceLeftNode) String() string {
+	return "PadSpaceLeft"
+}
+
+func (n *PadSpaceLeftNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type PadSpaceRightNode struct {
+	Arguments [2]Node
+}
+
+func (n *PadSpaceRightNode) String() string {
+	return "PadSpaceRight"
+}
+
+func (n *PadSpaceRightNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type ParseNumberNode struct {
+	Argument Node
+}
+
+func (n *ParseNumberNode) String() string {
+	return "ParseNumber"
+}
+
+func (n *ParseNumberNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type ParseTimeNode struct {
+	Argument Node
+}
+
+func (n *ParseTimeNode) String() string {
+	return "ParseTime"
+}
+
+func (n *ParseTimeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type ParseTimeLayoutNode struct {
+	Arguments [2]Node
+}
+
+func (n *ParseTimeLayoutNode) String() string {
+	return "ParseTimeLayout"
+}
+
+func (n *ParseTimeLayoutNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type PascalCaseNode struct {
+	Argument Node
+}
+
+func (n *PascalCaseNode) String() string {
+	return "PascalCase"
+}
+
+func (n *PascalCaseNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type PercentileNode struct {
+	Arguments [2]Node
+}
+
+func (n *PercentileNode) String() string {
+	return "Percentile"
+}
+
+func (n *PercentileNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type PickNode struct {
+	Arguments [2]Node
+}
+
+func (n *PickNode) String() string {
+	return "Pick"
+}
+
+func (n *PickNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type PipeNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *PipeNode) String() string {
+	return "Pipe"
+}
+
+func (n *PipeNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type PipeFieldNode struct {
+	Left  Node
+	Right string
+}
+
+func (n *PipeFieldNode) String() string {
+	return "PipeField: " + n.Right
+}
+
+func (n *PipeFieldNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+}
+
+type PowNode struct {
+	Arguments [2]Node
+}
+
+func (n *PowNode) String() string {
+	return "Pow"
+}
+
+func (n *PowNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type ProjectArrayNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *ProjectArrayNode) String() string {
+	return "ProjectArray"
+}
+
+func (n *ProjectArrayNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type ProjectArrayCurrentNode struct {
+	Child Node
+}
+
+func (n *ProjectArrayCurrentNode) String() string {
+	return "ProjectArrayCurrent"
+}
+
+func (n *ProjectArrayCurrentNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type ProjectObjectNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *ProjectObjectNode) String() string {
+	return "ProjectObject"
+}
+
+func (n *ProjectObjectNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type ProjectObjectCurrentNode struct {
+	Child Node
+}
+
+func (n *ProjectObjectCurrentNode) String() string {
+	return "ProjectObjectCurrent"
+}
+
+func (n *ProjectObjectCurrentNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type PruneArrayNode struct {
+	Child Node
+}
+
+func (n *PruneArrayNode) String() string {
+	return "PruneArray"
+}
+
+func (n *PruneArrayNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type PruneArrayCurrentNode struct{}
+
+func (n PruneArrayCurrentNode) String() string {
+	return "PruneArrayCurrent"
+}
+
+type RangeNode struct {
+	Arguments [2]Node
+}
+
+func (n *RangeNode) String() string {
+	return "Range"
+}
+
+func (n *RangeNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RangeStepNode struct {
+	Arguments [3]Node
+}
+
+func (n *RangeStepNode) String() string {
+	return "RangeStep"
+}
+
+func (n *RangeStepNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type RankNode struct {
+	Arguments [2]Node
+}
+
+func (n *RankNode) String() string {
+	return "Rank"
+}
+
+func (n *RankNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type ReduceNode struct {
+	Arguments [3]Node
+}
+
+func (n *ReduceNode) String() string {
+	return "Reduce"
+}
+
+func (n *ReduceNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type RegexFindNode struct {
+	Arguments [2]Node
+}
+
+func (n *RegexFindNode) String() string {
+	return "RegexFind"
+}
+
+func (n *RegexFindNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RegexFindAllNode struct {
+	Arguments [2]Node
+}
+
+func (n *RegexFindAllNode) String() string {
+	return "RegexFindAll"
+}
+
+func (n *RegexFindAllNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RegexMatchNode struct {
+	Arguments [2]Node
+}
+
+func (n *RegexMatchNode) String() string {
+	return "RegexMatch"
+}
+
+func (n *RegexMatchNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RegexReplaceNode struct {
+	Arguments [3]Node
+}
+
+func (n *RegexReplaceNode) String() string {
+	return "RegexReplace"
+}
+
+func (n *RegexReplaceNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type RegexSplitNode struct {
+	Arguments [2]Node
+}
+
+func (n *RegexSplitNode) String() string {
+	return "RegexSplit"
+}
+
+func (n *RegexSplitNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RegexpNode struct {
+	Value *regexp.Regexp
+}
+
+func (n *RegexpNode) String() string {
+	return "Regexp: " + n.Value.String()
+}
+
+type RemoveAtNode struct {
+	Arguments [2]Node
+}
+
+func (n *RemoveAtNode) String() string {
+	return "RemoveAt"
+}
+
+func (n *RemoveAtNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RenameKeysNode struct {
+	Arguments [2]Node
+}
+
+func (n *RenameKeysNode) String() string {
+	return "RenameKeys"
+}
+
+func (n *RenameKeysNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RepeatNode struct {
+	Arguments [2]Node
+}
+
+func (n *RepeatNode) String() string {
+	return "Repeat"
+}
+
+func (n *RepeatNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RepeatStringNode struct {
+	Arguments [2]Node
+}
+
+func (n *RepeatStringNode) String() string {
+	return "RepeatString"
+}
+
+func (n *RepeatStringNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type ReplaceNode struct {
+	Arguments [3]Node
+}
+
+func (n *ReplaceNode) String() string {
+	return "Replace"
+}
+
+func (n *ReplaceNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type ReplaceCountNode struct {
+	Arguments [4]Node
+}
+
+func (n *ReplaceCountNode) String() string {
+	return "ReplaceCount"
+}
+
+func (n *ReplaceCountNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+	v.Visit(n.Arguments[3])
+}
+
+type ReverseNode struct {
+	Argument Node
+}
+
+func (n *ReverseNode) String() string {
+	return "Reverse"
+}
+
+func (n *ReverseNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type RootNode struct{}
+
+func (n RootNode) String() string {
+	return "Root"
+}
+
+type RoundNode struct {
+	Arguments [2]Node
+}
+
+func (n *RoundNode) String() string {
+	return "Round"
+}
+
+func (n *RoundNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type RoundModeNode struct {
+	Arguments [3]Node
+}
+
+func (n *RoundModeNode) String() string {
+	return "RoundMode"
+}
+
+func (n *RoundModeNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type RunningAvgNode struct {
+	Arguments [2]Node
+}
+
+func (n *RunningAvgNode) String() string {
+	return "RunningAvg"
+}
+
+func (n *RunningAvgNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type SHA1Node struct {
+	Argument Node
+}
+
+func (n *SHA1Node) String() string {
+	return "SHA1"
+}
+
+func (n *SHA1Node) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SHA256Node struct {
+	Argument Node
+}
+
+func (n *SHA256Node) String() string {
+	return "SHA256"
+}
+
+func (n *SHA256Node) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SHA512Node struct {
+	Argument Node
+}
+
+func (n *SHA512Node) String() string {
+	return "SHA512"
+}
+
+func (n *SHA512Node) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SelectArrayNode struct {
+	Child  Node
+	Fields []Node
+}
+
+func (n *SelectArrayNode) String() string {
+	return "SelectArray"
+}
+
+func (n *SelectArrayNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+
+	for _, field := range n.Fields {
+		v.Visit(field)
+	}
+}
+
+type SelectArrayCurrentNode struct {
+	Fields []Node
+}
+
+func (n *SelectArrayCurrentNode) String() string {
+	return "SelectArrayCurrent"
+}
+
+func (n *SelectArrayCurrentNode) Walk(v Visitor) {
+	for _, field := range n.Fields {
+		v.Visit(field)
+	}
+}
+
+type SelectArrayEntry struct {
+	Field  Node
+	Spread bool
+}
+
+type SelectArrayEntriesNode struct {
+	Child   Node
+	Entries []SelectArrayEntry
+}
+
+func (n *SelectArrayEntriesNode) String() string {
+	return "SelectArrayEntries"
+}
+
+func (n *SelectArrayEntriesNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+
+	for _, entry := range n.Entries {
+		v.Visit(entry.Field)
+	}
+}
+
+type SelectArrayEntriesCurrentNode struct {
+	Entries []SelectArrayEntry
+}
+
+func (n *SelectArrayEntriesCurrentNode) String() string {
+	return "SelectArrayEntriesCurrent"
+}
+
+func (n *SelectArrayEntriesCurrentNode) Walk(v Visitor) {
+	for _, entry := range n.Entries {
+		v.Visit(entry.Field)
+	}
+}
+
+type SelectArraySingleNode struct {
+	Child Node
+	Field Node
+}
+
+func (n *SelectArraySingleNode) String() string {
+	return "SelectArraySingle"
+}
+
+func (n *SelectArraySingleNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+	v.Visit(n.Field)
+}
+
+type SelectArraySingleCurrentNode struct {
+	Field Node
+}
+
+func (n *SelectArraySingleCurrentNode) String() string {
+	return "SelectArraySingleCurrent"
+}
+
+func (n *SelectArraySingleCurrentNode) Walk(v Visitor) {
+	v.Visit(n.Field)
 }
 
 type SelectObjectNode struct {
@@ -1038,229 +2378,538 @@ type SelectObjectNode struct {
 	Fields map[string]Node
 }
 
-func (n *SelectObjectNode) String() string {
-	return "SelectObject"
+func (n *SelectObjectNode) String() string {
+	return "SelectObject"
+}
+
+func (n *SelectObjectNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+
+	for _, field := range n.Fields {
+		v.Visit(field)
+	}
+}
+
+type SelectObjectCurrentNode struct {
+	Fields map[string]Node
+}
+
+func (n *SelectObjectCurrentNode) String() string {
+	return "SelectObjectCurrent"
+}
+
+func (n *SelectObjectCurrentNode) Walk(v Visitor) {
+	for _, field := range n.Fields {
+		v.Visit(field)
+	}
+}
+
+type SelectObjectEntry struct {
+	Key    Node
+	Field  Node
+	Spread bool
+}
+
+type SelectObjectEntriesNode struct {
+	Child   Node
+	Entries []SelectObjectEntry
+}
+
+func (n *SelectObjectEntriesNode) String() string {
+	return "SelectObjectEntries"
+}
+
+func (n *SelectObjectEntriesNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+
+	for _, entry := range n.Entries {
+		if entry.Key != nil {
+			v.Visit(entry.Key)
+		}
+
+		v.Visit(entry.Field)
+	}
 }
 
-func (n *SelectObjectNode) Walk(v Visitor) {
+type SelectObjectEntriesCurrentNode struct {
+	Entries []SelectObjectEntry
+}
+
+func (n *SelectObjectEntriesCurrentNode) String() string {
+	return "SelectObjectEntriesCurrent"
+}
+
+func (n *SelectObjectEntriesCurrentNode) Walk(v Visitor) {
+	for _, entry := range n.Entries {
+		if entry.Key != nil {
+			v.Visit(entry.Key)
+		}
+
+		v.Visit(entry.Field)
+	}
+}
+
+type SelectObjectSingleNode struct {
+	Child Node
+	Key   string
+	Field Node
+}
+
+func (n *SelectObjectSingleNode) String() string {
+	return "SelectObjectSingle"
+}
+
+func (n *SelectObjectSingleNode) Walk(v Visitor) {
 	v.Visit(n.Child)
+	v.Visit(n.Field)
+}
 
-	for _, field := range n.Fields {
-		v.Visit(field)
+type SelectObjectSingleCurrentNode struct {
+	Key   string
+	Field Node
+}
+
+func (n *SelectObjectSingleCurrentNode) String() string {
+	return "SelectObjectSingleCurrent"
+}
+
+func (n *SelectObjectSingleCurrentNode) Walk(v Visitor) {
+	v.Visit(n.Field)
+}
+
+type SignNode struct {
+	Argument Node
+}
+
+func (n *SignNode) String() string {
+	return "Sign"
+}
+
+func (n *SignNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SliceNode struct {
+	Child Node
+	Start int
+	Stop  int
+}
+
+func (n *SliceNode) String() string {
+	return "Slice: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop)
+}
+
+func (n *SliceNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type SliceCurrentNode struct {
+	Start int
+	Stop  int
+}
+
+func (n *SliceCurrentNode) String() string {
+	return "SliceCurrent: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop)
+}
+
+type SliceStepNode struct {
+	Child Node
+	Start int
+	Stop  int
+	Step  int
+}
+
+func (n *SliceStepNode) String() string {
+	return "SliceStep: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop) + ":" + strconv.Itoa(n.Step)
+}
+
+func (n *SliceStepNode) Walk(v Visitor) {
+	v.Visit(n.Child)
+}
+
+type SliceStepCurrentNode struct {
+	Start int
+	Stop  int
+	Step  int
+}
+
+func (n *SliceStepCurrentNode) String() string {
+	return "SliceStepCurrent: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop) + ":" + strconv.Itoa(n.Step)
+}
+
+type SmallIndexCurrentNode struct {
+	Value uint8
+}
+
+func (n SmallIndexCurrentNode) String() string {
+	return "SmallIndexCurrent: " + strconv.FormatUint(uint64(n.Value), 10)
+}
+
+type SnakeCaseNode struct {
+	Argument Node
+}
+
+func (n *SnakeCaseNode) String() string {
+	return "SnakeCase"
+}
+
+func (n *SnakeCaseNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SortNode struct {
+	Argument Node
+}
+
+func (n *SortNode) String() string {
+	return "Sort"
+}
+
+func (n *SortNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SortByNode struct {
+	Arguments [2]Node
+}
+
+func (n *SortByNode) String() string {
+	return "SortBy"
+}
+
+func (n *SortByNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type SplitNode struct {
+	Arguments [2]Node
+}
+
+func (n *SplitNode) String() string {
+	return "Split"
+}
+
+func (n *SplitNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type SplitCountNode struct {
+	Arguments [3]Node
+}
+
+func (n *SplitCountNode) String() string {
+	return "SplitCount"
+}
+
+func (n *SplitCountNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type SprintfNode struct {
+	Arguments []Node
+}
+
+func (n *SprintfNode) String() string {
+	return "Sprintf"
+}
+
+func (n *SprintfNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
 	}
 }
 
-type SelectObjectCurrentNode struct {
-	Fields map[string]Node
+type SqrtNode struct {
+	Argument Node
+}
+
+func (n *SqrtNode) String() string {
+	return "Sqrt"
+}
+
+func (n *SqrtNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type StartsWithNode struct {
+	Arguments [2]Node
+}
+
+func (n *StartsWithNode) String() string {
+	return "StartsWith"
+}
+
+func (n *StartsWithNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type StddevNode struct {
+	Argument Node
+}
+
+func (n *StddevNode) String() string {
+	return "Stddev"
+}
+
+func (n *StddevNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SubtractNode struct {
+	Left  Node
+	Right Node
+}
+
+func (n *SubtractNode) String() string {
+	return "Subtract"
+}
+
+func (n *SubtractNode) Walk(v Visitor) {
+	v.Visit(n.Left)
+	v.Visit(n.Right)
+}
+
+type SumNode struct {
+	Argument Node
+}
+
+func (n *SumNode) String() string {
+	return "Sum"
+}
+
+func (n *SumNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type SumByNode struct {
+	Arguments [2]Node
+}
+
+func (n *SumByNode) String() string {
+	return "SumBy"
+}
+
+func (n *SumByNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type SymmetricDifferenceNode struct {
+	Arguments [2]Node
+}
+
+func (n *SymmetricDifferenceNode) String() string {
+	return "SymmetricDifference"
+}
+
+func (n *SymmetricDifferenceNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type TakeNode struct {
+	Arguments [2]Node
+}
+
+func (n *TakeNode) String() string {
+	return "Take"
+}
+
+func (n *TakeNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type TemplateNode struct {
+	Parts []Node
 }
 
-func (n *SelectObjectCurrentNode) String() string {
-	return "SelectObjectCurrent"
+func (n *TemplateNode) String() string {
+	return "Template"
 }
 
-func (n *SelectObjectCurrentNode) Walk(v Visitor) {
-	for _, field := range n.Fields {
-		v.Visit(field)
+func (n *TemplateNode) Walk(v Visitor) {
+	for _, part := range n.Parts {
+		v.Visit(part)
 	}
 }
 
-type SelectObjectSingleNode struct {
-	Child Node
-	Key   string
-	Field Node
+type TimeDiffNode struct {
+	Arguments [2]Node
 }
 
-func (n *SelectObjectSingleNode) String() string {
-	return "SelectObjectSingle"
+func (n *TimeDiffNode) String() string {
+	return "TimeDiff"
 }
 
-func (n *SelectObjectSingleNode) Walk(v Visitor) {
-	v.Visit(n.Child)
-	v.Visit(n.Field)
+func (n *TimeDiffNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-type SelectObjectSingleCurrentNode struct {
-	Key   string
-	Field Node
+type TimePartNode struct {
+	Arguments [2]Node
 }
 
-func (n *SelectObjectSingleCurrentNode) String() string {
-	return "SelectObjectSingleCurrent"
+func (n *TimePartNode) String() string {
+	return "TimePart"
 }
 
-func (n *SelectObjectSingleCurrentNode) Walk(v Visitor) {
-	v.Visit(n.Field)
+func (n *TimePartNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
-type SliceNode struct {
-	Child Node
-	Start int
-	Stop  int
+type TitleCaseNode struct {
+	Argument Node
 }
 
-func (n *SliceNode) String() string {
-	return "Slice: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop)
+func (n *TitleCaseNode) String() string {
+	return "TitleCase"
 }
 
-func (n *SliceNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+func (n *TitleCaseNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type SliceCurrentNode struct {
-	Start int
-	Stop  int
+type ToArrayNode struct {
+	Argument Node
 }
 
-func (n *SliceCurrentNode) String() string {
-	return "SliceCurrent: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop)
+func (n *ToArrayNode) String() string {
+	return "ToArray"
 }
 
-type SliceStepNode struct {
-	Child Node
-	Start int
-	Stop  int
-	Step  int
+func (n *ToArrayNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *SliceStepNode) String() string {
-	return "SliceStep: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop) + ":" + strconv.Itoa(n.Step)
+type ToBooleanNode struct {
+	Argument Node
 }
 
-func (n *SliceStepNode) Walk(v Visitor) {
-	v.Visit(n.Child)
+func (n *ToBooleanNode) String() string {
+	return "ToBoolean"
 }
 
-type SliceStepCurrentNode struct {
-	Start int
-	Stop  int
-	Step  int
+func (n *ToBooleanNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-func (n *SliceStepCurrentNode) String() string {
-	return "SliceStepCurrent: " + strconv.Itoa(n.Start) + ":" + strconv.Itoa(n.Stop) + ":" + strconv.Itoa(n.Step)
+type ToEpochNode struct {
+	Argument Node
 }
 
-type SmallIndexCurrentNode struct {
-	Value uint8
+func (n *ToEpochNode) String() string {
+	return "ToEpoch"
 }
 
-func (n SmallIndexCurrentNode) String() string {
-	return "SmallIndexCurrent: " + strconv.FormatUint(uint64(n.Value), 10)
+func (n *ToEpochNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type SortNode struct {
+type ToEpochMillisNode struct {
 	Argument Node
 }
 
-func (n *SortNode) String() string {
-	return "Sort"
+func (n *ToEpochMillisNode) String() string {
+	return "ToEpochMillis"
 }
 
-func (n *SortNode) Walk(v Visitor) {
+func (n *ToEpochMillisNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type SortByNode struct {
-	Arguments [2]Node
+type ToIntegerNode struct {
+	Argument Node
 }
 
-func (n *SortByNode) String() string {
-	return "SortBy"
+func (n *ToIntegerNode) String() string {
+	return "ToInteger"
 }
 
-func (n *SortByNode) Walk(v Visitor) {
-	v.Visit(n.Arguments[0])
-	v.Visit(n.Arguments[1])
+func (n *ToIntegerNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type SplitNode struct {
+type ToIntegerModeNode struct {
 	Arguments [2]Node
 }
 
-func (n *SplitNode) String() string {
-	return "Split"
+func (n *ToIntegerModeNode) String() string {
+	return "ToIntegerMode"
 }
 
-func (n *SplitNode) Walk(v Visitor) {
+func (n *ToIntegerModeNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
 }
 
-type SplitCountNode struct {
-	Arguments [3]Node
+type ToJSONNode struct {
+	Argument Node
 }
 
-func (n *SplitCountNode) String() string {
-	return "SplitCount"
+func (n *ToJSONNode) String() string {
+	return "ToJSON"
 }
 
-func (n *SplitCountNode) Walk(v Visitor) {
-	v.Visit(n.Arguments[0])
-	v.Visit(n.Arguments[1])
-	v.Visit(n.Arguments[2])
+func (n *ToJSONNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
 }
 
-type StartsWithNode struct {
+type ToJSONOptionsNode struct {
 	Arguments [2]Node
 }
 
-func (n *StartsWithNode) String() string {
-	return "StartsWith"
+func (n *ToJSONOptionsNode) String() string {
+	return "ToJSONOptions"
 }
 
-func (n *StartsWithNode) Walk(v Visitor) {
+func (n *ToJSONOptionsNode) Walk(v Visitor) {
 	v.Visit(n.Arguments[0])
 	v.Visit(n.Arguments[1])
 }
 
-type SubtractNode struct {
-	Left  Node
-	Right Node
-}
-
-func (n *SubtractNode) String() string {
-	return "Subtract"
-}
-
-func (n *SubtractNode) Walk(v Visitor) {
-	v.Visit(n.Left)
-	v.Visit(n.Right)
-}
-
-type SumNode struct {
+type ToNumberNode struct {
 	Argument Node
 }
 
-func (n *SumNode) String() string {
-	return "Sum"
+func (n *ToNumberNode) String() string {
+	return "ToNumber"
 }
 
-func (n *SumNode) Walk(v Visitor) {
+func (n *ToNumberNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type ToArrayNode struct {
+type ToObjectNode struct {
 	Argument Node
 }
 
-func (n *ToArrayNode) String() string {
-	return "ToArray"
+func (n *ToObjectNode) String() string {
+	return "ToObject"
 }
 
-func (n *ToArrayNode) Walk(v Visitor) {
+func (n *ToObjectNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
-type ToNumberNode struct {
-	Argument Node
+type ToObjectKeyNode struct {
+	Arguments [2]Node
 }
 
-func (n *ToNumberNode) String() string {
-	return "ToNumber"
+func (n *ToObjectKeyNode) String() string {
+	return "ToObjectKey"
 }
 
-func (n *ToNumberNode) Walk(v Visitor) {
-	v.Visit(n.Argument)
+func (n *ToObjectKeyNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
 }
 
 type ToStringNode struct {
@@ -1350,6 +2999,46 @@ func (n *TrimSpaceRightNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
+type TruncateNode struct {
+	Arguments [2]Node
+}
+
+func (n *TruncateNode) String() string {
+	return "Truncate"
+}
+
+func (n *TruncateNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type TruncateSuffixNode struct {
+	Arguments [3]Node
+}
+
+func (n *TruncateSuffixNode) String() string {
+	return "TruncateSuffix"
+}
+
+func (n *TruncateSuffixNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type TruncateTimeNode struct {
+	Arguments [2]Node
+}
+
+func (n *TruncateTimeNode) String() string {
+	return "TruncateTime"
+}
+
+func (n *TruncateTimeNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type TypeNode struct {
 	Argument Node
 }
@@ -1362,6 +3051,69 @@ func (n *TypeNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
+type URLDecodeNode struct {
+	Argument Node
+}
+
+func (n *URLDecodeNode) String() string {
+	return "URLDecode"
+}
+
+func (n *URLDecodeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type URLEncodeNode struct {
+	Argument Node
+}
+
+func (n *URLEncodeNode) String() string {
+	return "URLEncode"
+}
+
+func (n *URLEncodeNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type UnionNode struct {
+	Arguments []Node
+}
+
+func (n *UnionNode) String() string {
+	return "Union"
+}
+
+func (n *UnionNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
+}
+
+type UniqueNode struct {
+	Argument Node
+}
+
+func (n *UniqueNode) String() string {
+	return "Unique"
+}
+
+func (n *UniqueNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type UniqueByNode struct {
+	Arguments [2]Node
+}
+
+func (n *UniqueByNode) String() string {
+	return "UniqueBy"
+}
+
+func (n *UniqueByNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
 type UpperNode struct {
 	Argument Node
 }
@@ -1374,6 +3126,21 @@ func (n *UpperNode) Walk(v Visitor) {
 	v.Visit(n.Argument)
 }
 
+type UserFunctionNode struct {
+	Name      string
+	Arguments []Node
+}
+
+func (n *UserFunctionNode) String() string {
+	return "UserFunction: " + n.Name
+}
+
+func (n *UserFunctionNode) Walk(v Visitor) {
+	for _, arg := range n.Arguments {
+		v.Visit(arg)
+	}
+}
+
 type ValueNode struct {
 	Value any
 }
@@ -1409,6 +3176,57 @@ func (n *VariableNode) String() string {
 	return "Variable: " + n.Name
 }
 
+type VarianceNode struct {
+	Argument Node
+}
+
+func (n *VarianceNode) String() string {
+	return "Variance"
+}
+
+func (n *VarianceNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
+type WindowNode struct {
+	Arguments [2]Node
+}
+
+func (n *WindowNode) String() string {
+	return "Window"
+}
+
+func (n *WindowNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+}
+
+type WindowStepNode struct {
+	Arguments [3]Node
+}
+
+func (n *WindowStepNode) String() string {
+	return "WindowStep"
+}
+
+func (n *WindowStepNode) Walk(v Visitor) {
+	v.Visit(n.Arguments[0])
+	v.Visit(n.Arguments[1])
+	v.Visit(n.Arguments[2])
+}
+
+type WordsNode struct {
+	Argument Node
+}
+
+func (n *WordsNode) String() string {
+	return "Words"
+}
+
+func (n *WordsNode) Walk(v Visitor) {
+	v.Visit(n.Argument)
+}
+
 type ZipNode struct {
 	Arguments []Node
 }
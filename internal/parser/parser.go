@@ -5,16 +5,24 @@ import (
 	"errors"
 	"io"
 	"math"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode/utf16"
+	"unicode/utf8"
 
 	"github.com/woodsbury/jmespath/internal/lexer"
 )
 
 func Parse(expression string) (Node, error) {
+	return parse(expression, nil)
+}
+
+func parse(expression string, functions *functionScope) (Node, error) {
 	p := parser{
-		lex: lexer.NewLexer(expression),
+		lex:       lexer.NewLexer(expression),
+		functions: functions,
 	}
 
 	if err := p.lex.Next(&p.curr); err != nil {
@@ -29,9 +37,11 @@ func Parse(expression string) (Node, error) {
 }
 
 type parser struct {
-	lex  lexer.Lexer
-	curr lexer.Token
-	next lexer.Token
+	lex        lexer.Lexer
+	curr       lexer.Token
+	next       lexer.Token
+	letBinding bool
+	functions  *functionScope
 }
 
 func (p *parser) advance() error {
@@ -47,12 +57,97 @@ func (p *parser) advance2() error {
 	return p.lex.Next(&p.next)
 }
 
+func (p *parser) declareFunctions(scope *functionScope) {
+	s := *p
+	depth := 0
+	lets := 0
+	binding := true
+	for {
+		if binding && depth == 0 && lets == 0 && s.curr.Type == lexer.UnquotedIdentifierToken && s.curr.Value == "fn" && s.next.Type == lexer.VariableToken {
+			name := s.next.Value
+
+			if err := s.advance2(); err != nil {
+				return
+			}
+
+			if s.curr.Type != lexer.OpenParenToken {
+				return
+			}
+
+			arity := 0
+			for s.curr.Type != lexer.CloseParenToken {
+				if s.curr.Type == lexer.EndToken {
+					return
+				}
+
+				if s.curr.Type == lexer.VariableToken {
+					arity++
+				}
+
+				if err := s.advance(); err != nil {
+					return
+				}
+			}
+
+			scope.functions[name] = arity
+
+			if err := s.advance(); err != nil {
+				return
+			}
+		}
+
+		binding = false
+
+		switch s.curr.Type {
+		case lexer.EndToken:
+			return
+		case lexer.CloseBraceToken,
+			lexer.CloseParenToken,
+			lexer.CloseSqBraceToken:
+			depth--
+		case lexer.CommaToken:
+			binding = depth == 0 && lets == 0
+		case lexer.FilterToken,
+			lexer.OpenBraceToken,
+			lexer.OpenParenToken,
+			lexer.OpenSqBraceToken:
+			depth++
+		case lexer.InToken:
+			if depth == 0 {
+				if lets == 0 {
+					return
+				}
+
+				lets--
+			}
+		case lexer.LetToken:
+			if depth == 0 {
+				lets++
+			}
+		case lexer.UnquotedIdentifierToken:
+			if s.curr.Value == "not" && s.next.Type == lexer.InToken {
+				if err := s.advance(); err != nil {
+					return
+				}
+			}
+		}
+
+		if err := s.advance(); err != nil {
+			return
+		}
+	}
+}
+
 func (p *parser) expression(prec int) (Node, error) {
 	node, err := p.primaryExpression()
 	if err != nil {
 		return nil, err
 	}
 
+	if err := p.notIn(); err != nil {
+		return nil, err
+	}
+
 	newPrec := precedence(p.curr.Type)
 	for newPrec > prec {
 		switch p.curr.Type {
@@ -237,6 +332,31 @@ func (p *parser) expression(prec int) (Node, error) {
 						return nil, err
 					}
 				}
+			case lexer.VariableToken:
+				if err := p.advance(); err != nil {
+					return nil, err
+				}
+
+				if p.next.Type != lexer.OpenParenToken {
+					return nil, &unexpectedTokenError{p.curr.Value}
+				}
+
+				right, err := p.expression(newPrec)
+				if err != nil {
+					return nil, err
+				}
+
+				if isProjectNode(node) {
+					node = &ProjectArrayNode{
+						Left:  node,
+						Right: right,
+					}
+				} else {
+					node = &PipeNode{
+						Left:  node,
+						Right: right,
+					}
+				}
 			default:
 				return nil, &unexpectedTokenError{p.curr.Value}
 			}
@@ -357,6 +477,24 @@ func (p *parser) expression(prec int) (Node, error) {
 				Then:      ifThen,
 				Else:      ifElse,
 			}, nil
+		case lexer.InToken:
+			if p.letBinding {
+				return node, nil
+			}
+
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			right, err := p.expression(newPrec)
+			if err != nil {
+				return nil, err
+			}
+
+			node = &InNode{
+				Left:  node,
+				Right: right,
+			}
 		case lexer.IntegerDivideToken:
 			if err := p.advance(); err != nil {
 				return nil, err
@@ -399,6 +537,25 @@ func (p *parser) expression(prec int) (Node, error) {
 				Left:  node,
 				Right: right,
 			}
+		case lexer.MatchToken:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			right, err := p.expression(newPrec)
+			if err != nil {
+				return nil, err
+			}
+
+			right, err = compileRegexp(right)
+			if err != nil {
+				return nil, err
+			}
+
+			node = &MatchNode{
+				Left:  node,
+				Right: right,
+			}
 		case lexer.ModuloToken:
 			if err := p.advance(); err != nil {
 				return nil, err
@@ -427,6 +584,55 @@ func (p *parser) expression(prec int) (Node, error) {
 				Left:  node,
 				Right: right,
 			}
+		case lexer.NotInToken:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			right, err := p.expression(newPrec)
+			if err != nil {
+				return nil, err
+			}
+
+			node = &NotNode{
+				Child: &InNode{
+					Left:  node,
+					Right: right,
+				},
+			}
+		case lexer.NotMatchToken:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			right, err := p.expression(newPrec)
+			if err != nil {
+				return nil, err
+			}
+
+			right, err = compileRegexp(right)
+			if err != nil {
+				return nil, err
+			}
+
+			node = &NotMatchNode{
+				Left:  node,
+				Right: right,
+			}
+		case lexer.NullCoalesceToken:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			right, err := p.expression(newPrec)
+			if err != nil {
+				return nil, err
+			}
+
+			node = &NullCoalesceNode{
+				Left:  node,
+				Right: right,
+			}
 		case lexer.ObjectWildcardToken:
 			if err := p.advance(); err != nil {
 				return nil, err
@@ -517,6 +723,10 @@ func (p *parser) expression(prec int) (Node, error) {
 			return node, nil
 		}
 
+		if err := p.notIn(); err != nil {
+			return nil, err
+		}
+
 		newPrec = precedence(p.curr.Type)
 	}
 
@@ -524,6 +734,8 @@ func (p *parser) expression(prec int) (Node, error) {
 }
 
 func (p *parser) filter() (Node, error) {
+	defer p.setLetBinding(p.setLetBinding(false))
+
 	node, err := p.expression(1)
 	if err != nil {
 		return nil, err
@@ -541,6 +753,8 @@ func (p *parser) filter() (Node, error) {
 }
 
 func (p *parser) function() (Node, error) {
+	defer p.setLetBinding(p.setLetBinding(false))
+
 	name := p.curr.Value
 
 	if err := p.advance2(); err != nil {
@@ -557,242 +771,1085 @@ func (p *parser) function() (Node, error) {
 		return &AbsNode{
 			Argument: arg,
 		}, nil
-	case "avg":
-		arg, err := p.function1Arg(name)
-		if err != nil {
-			return nil, err
-		}
-
-		return &AvgNode{
-			Argument: arg,
-		}, nil
-	case "ceil":
-		arg, err := p.function1Arg(name)
+	case "add_duration":
+		arg1, arg2, err := p.function2Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &CeilNode{
-			Argument: arg,
+		return &AddDurationNode{
+			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "contains":
-		arg1, arg2, err := p.function2Arg(name)
+	case "all":
+		arg1, arg2, err := p.function2ExpArg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &ContainsNode{
+		return &AllNode{
 			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "ends_with":
-		arg1, arg2, err := p.function2Arg(name)
+	case "any":
+		arg1, arg2, err := p.function2ExpArg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &EndsWithNode{
+		return &AnyNode{
 			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "find_first":
-		arg1, arg2, arg3, arg4, err := p.function2To4Arg(name)
+	case "avg":
+		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		if arg3 == nil {
-			return &FindFirstNode{
-				Arguments: [2]Node{arg1, arg2},
-			}, nil
-		}
-
-		if arg4 == nil {
-			return &FindFirstFromNode{
-				Arguments: [3]Node{arg1, arg2, arg3},
-			}, nil
-		}
-
-		return &FindFirstBetweenNode{
-			Arguments: [4]Node{arg1, arg2, arg3, arg4},
+		return &AvgNode{
+			Argument: arg,
 		}, nil
-	case "find_last":
-		arg1, arg2, arg3, arg4, err := p.function2To4Arg(name)
+	case "avg_by":
+		arg1, arg2, err := p.function2ExpArg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		if arg3 == nil {
-			return &FindLastNode{
-				Arguments: [2]Node{arg1, arg2},
-			}, nil
-		}
-
-		if arg4 == nil {
-			return &FindLastFromNode{
-				Arguments: [3]Node{arg1, arg2, arg3},
-			}, nil
-		}
-
-		return &FindLastBetweenNode{
-			Arguments: [4]Node{arg1, arg2, arg3, arg4},
+		return &AvgByNode{
+			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "floor":
+	case "base64_decode":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &FloorNode{
+		return &Base64DecodeNode{
 			Argument: arg,
 		}, nil
-	case "from_items":
+	case "base64_encode":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &FromItemsNode{
+		return &Base64EncodeNode{
 			Argument: arg,
 		}, nil
-	case "group_by":
-		arg1, arg2, err := p.function2ExpArg(name)
+	case "base64_url_decode":
+		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &GroupByNode{
-			Arguments: [2]Node{arg1, arg2},
+		return &Base64URLDecodeNode{
+			Argument: arg,
 		}, nil
-	case "items":
+	case "base64_url_encode":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &ItemsNode{
+		return &Base64URLEncodeNode{
 			Argument: arg,
 		}, nil
-	case "join":
-		arg1, arg2, err := p.function2Arg(name)
+	case "camel_case":
+		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &JoinNode{
-			Arguments: [2]Node{arg1, arg2},
+		return &CamelCaseNode{
+			Argument: arg,
 		}, nil
-	case "keys":
+	case "casefold":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &KeysNode{
+		return &CasefoldNode{
 			Argument: arg,
 		}, nil
-	case "length":
+	case "ceil":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &LengthNode{
+		return &CeilNode{
 			Argument: arg,
 		}, nil
-	case "lower":
-		arg, err := p.function1Arg(name)
+	case "chunk":
+		arg1, arg2, err := p.function2Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &LowerNode{
-			Argument: arg,
+		return &ChunkNode{
+			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "map":
-		arg1, arg2, err := p.function2MapArg(name)
+	case "clamp":
+		arg1, arg2, arg3, err := p.function3Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &MapNode{
-			Arguments: [2]Node{arg1, arg2},
+		return &ClampNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
 		}, nil
-	case "max":
+	case "coalesce":
+		return p.functionNotNull(name)
+	case "compact":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &MaxNode{
+		return &CompactNode{
 			Argument: arg,
 		}, nil
-	case "max_by":
+	case "contains":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ContainsNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "count":
 		arg1, arg2, err := p.function2ExpArg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &MaxByNode{
+		return &CountNode{
 			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "merge":
-		args, err := p.functionVarArg(name)
+	case "count_by":
+		arg1, arg2, err := p.function2ExpArg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &MergeNode{
-			Arguments: args,
+		return &CountByNode{
+			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "min":
+	case "crc32":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &MinNode{
+		return &CRC32Node{
 			Argument: arg,
 		}, nil
-	case "min_by":
+	case "cumsum":
 		arg1, arg2, err := p.function2ExpArg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &MinByNode{
+		return &CumsumNode{
 			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "not_null":
-		return p.functionNotNull()
-	case "pad_left":
-		arg1, arg2, arg3, err := p.function2To3Arg(name)
+	case "dense_rank":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &DenseRankNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "diff":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &DiffNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "difference":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &DifferenceNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "distinct":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &UniqueNode{
+			Argument: arg,
+		}, nil
+	case "drop":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &DropNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "ends_with":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &EndsWithNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "equals_ignore_case":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &EqualsIgnoreCaseNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "exp":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ExpNode{
+			Argument: arg,
+		}, nil
+	case "filter":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FilterArrayNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "find":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FindNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "find_first":
+		arg1, arg2, arg3, arg4, err := p.function2To4Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &FindFirstNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		if arg4 == nil {
+			return &FindFirstFromNode{
+				Arguments: [3]Node{arg1, arg2, arg3},
+			}, nil
+		}
+
+		return &FindFirstBetweenNode{
+			Arguments: [4]Node{arg1, arg2, arg3, arg4},
+		}, nil
+	case "find_index":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FindIndexNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "find_last":
+		arg1, arg2, arg3, arg4, err := p.function2To4Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &FindLastNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		if arg4 == nil {
+			return &FindLastFromNode{
+				Arguments: [3]Node{arg1, arg2, arg3},
+			}, nil
+		}
+
+		return &FindLastBetweenNode{
+			Arguments: [4]Node{arg1, arg2, arg3, arg4},
+		}, nil
+	case "flatten_deep":
+		arg1, arg2, err := p.function1To2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg2 == nil {
+			return &FlattenDeepNode{
+				Argument: arg1,
+			}, nil
+		}
+
+		return &FlattenDeepDepthNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "floor":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FloorNode{
+			Argument: arg,
+		}, nil
+	case "format":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FormatNode{
+			Arguments: args,
+		}, nil
+	case "format_time":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FormatTimeNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "from_epoch":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FromEpochNode{
+			Argument: arg,
+		}, nil
+	case "from_epoch_millis":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FromEpochMillisNode{
+			Argument: arg,
+		}, nil
+	case "from_items":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FromItemsNode{
+			Argument: arg,
+		}, nil
+	case "from_json":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FromJSONNode{
+			Argument: arg,
+		}, nil
+	case "get":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &GetNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &GetDefaultNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "grapheme_length":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GraphemeLengthNode{
+			Argument: arg,
+		}, nil
+	case "grapheme_reverse":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GraphemeReverseNode{
+			Argument: arg,
+		}, nil
+	case "grapheme_slice":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &GraphemeSliceNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &GraphemeSliceStopNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "greatest":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GreatestNode{
+			Arguments: args,
+		}, nil
+	case "group_by":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &GroupByNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "has":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &HasNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "hex_decode":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &HexDecodeNode{
+			Argument: arg,
+		}, nil
+	case "hex_encode":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &HexEncodeNode{
+			Argument: arg,
+		}, nil
+	case "html_escape":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &HTMLEscapeNode{
+			Argument: arg,
+		}, nil
+	case "indent":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IndentNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "index_of":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IndexOfNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "insert":
+		arg1, arg2, arg3, err := p.function3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &InsertNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "intersection":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IntersectionNode{
+			Arguments: args,
+		}, nil
+	case "is_array":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IsArrayNode{
+			Argument: arg,
+		}, nil
+	case "is_boolean":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IsBooleanNode{
+			Argument: arg,
+		}, nil
+	case "is_integer":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IsIntegerNode{
+			Argument: arg,
+		}, nil
+	case "is_null":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IsNullNode{
+			Argument: arg,
+		}, nil
+	case "is_number":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IsNumberNode{
+			Argument: arg,
+		}, nil
+	case "is_object":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IsObjectNode{
+			Argument: arg,
+		}, nil
+	case "is_string":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &IsStringNode{
+			Argument: arg,
+		}, nil
+	case "items":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ItemsNode{
+			Argument: arg,
+		}, nil
+	case "join":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &JoinNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "kebab_case":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &KebabCaseNode{
+			Argument: arg,
+		}, nil
+	case "keys":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &KeysNode{
+			Argument: arg,
+		}, nil
+	case "lag":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LagNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "lead":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LeadNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "least":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LeastNode{
+			Arguments: args,
+		}, nil
+	case "length":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LengthNode{
+			Argument: arg,
+		}, nil
+	case "lines":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LinesNode{
+			Argument: arg,
+		}, nil
+	case "ln":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LnNode{
+			Argument: arg,
+		}, nil
+	case "log":
+		arg1, arg2, err := p.function1To2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg2 == nil {
+			return &LogNode{
+				Argument: arg1,
+			}, nil
+		}
+
+		return &LogBaseNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "lower":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LowerNode{
+			Argument: arg,
+		}, nil
+	case "map":
+		arg1, arg2, err := p.function2MapArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MapNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "map_keys":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MapKeysNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "max":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MaxNode{
+			Argument: arg,
+		}, nil
+	case "max_by":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MaxByNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "md5":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MD5Node{
+			Argument: arg,
+		}, nil
+	case "median":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MedianNode{
+			Argument: arg,
+		}, nil
+	case "merge":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MergeNode{
+			Arguments: args,
+		}, nil
+	case "merge_deep":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MergeDeepNode{
+			Arguments: args,
+		}, nil
+	case "min":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MinNode{
+			Argument: arg,
+		}, nil
+	case "min_by":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &MinByNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "mode":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ModeNode{
+			Argument: arg,
+		}, nil
+	case "normalize":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &NormalizeNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "not_null":
+		return p.functionNotNull(name)
+	case "now":
+		if err := p.function0Arg(name); err != nil {
+			return nil, err
+		}
+
+		return NowNode{}, nil
+	case "omit":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &OmitNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "pad_left":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &PadSpaceLeftNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &PadLeftNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "pad_right":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &PadSpaceRightNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &PadRightNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "parse_json":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &FromJSONNode{
+			Argument: arg,
+		}, nil
+	case "parse_number":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ParseNumberNode{
+			Argument: arg,
+		}, nil
+	case "parse_time":
+		arg1, arg2, err := p.function1To2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg2 == nil {
+			return &ParseTimeNode{
+				Argument: arg1,
+			}, nil
+		}
+
+		return &ParseTimeLayoutNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "pascal_case":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &PascalCaseNode{
+			Argument: arg,
+		}, nil
+	case "percentile":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &PercentileNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "pick":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &PickNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "pow":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &PowNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "range":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &RangeNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &RangeStepNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "rank":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RankNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "reduce":
+		arg1, arg2, arg3, err := p.function3ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ReduceNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "regex_find":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err = compileRegexp(arg2)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RegexFindNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "regex_find_all":
+		arg1, arg2, err := p.function2Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		if arg3 == nil {
-			return &PadSpaceLeftNode{
-				Arguments: [2]Node{arg1, arg2},
-			}, nil
+		arg2, err = compileRegexp(arg2)
+		if err != nil {
+			return nil, err
 		}
 
-		return &PadLeftNode{
-			Arguments: [3]Node{arg1, arg2, arg3},
+		return &RegexFindAllNode{
+			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "pad_right":
-		arg1, arg2, arg3, err := p.function2To3Arg(name)
+	case "regex_match":
+		arg1, arg2, err := p.function2Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		if arg3 == nil {
-			return &PadSpaceRightNode{
-				Arguments: [2]Node{arg1, arg2},
-			}, nil
+		arg2, err = compileRegexp(arg2)
+		if err != nil {
+			return nil, err
 		}
 
-		return &PadRightNode{
+		return &RegexMatchNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "regex_replace":
+		arg1, arg2, arg3, err := p.function3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err = compileRegexp(arg2)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RegexReplaceNode{
 			Arguments: [3]Node{arg1, arg2, arg3},
 		}, nil
+	case "regex_split":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		arg2, err = compileRegexp(arg2)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RegexSplitNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "remove_at":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RemoveAtNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "rename_keys":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RenameKeysNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "repeat":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RepeatNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "repeat_string":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RepeatStringNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
 	case "replace":
 		arg1, arg2, arg3, arg4, err := p.function3To4Arg(name)
 		if err != nil {
@@ -808,83 +1865,305 @@ func (p *parser) function() (Node, error) {
 		return &ReplaceCountNode{
 			Arguments: [4]Node{arg1, arg2, arg3, arg4},
 		}, nil
-	case "reverse":
+	case "reverse":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ReverseNode{
+			Argument: arg,
+		}, nil
+	case "round":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &RoundNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &RoundModeNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "running_avg":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RunningAvgNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "sha1":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SHA1Node{
+			Argument: arg,
+		}, nil
+	case "sha256":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SHA256Node{
+			Argument: arg,
+		}, nil
+	case "sha512":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SHA512Node{
+			Argument: arg,
+		}, nil
+	case "sign":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SignNode{
+			Argument: arg,
+		}, nil
+	case "snake_case":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SnakeCaseNode{
+			Argument: arg,
+		}, nil
+	case "sort":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SortNode{
+			Argument: arg,
+		}, nil
+	case "sort_by":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SortByNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "split":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &SplitNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &SplitCountNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "sprintf":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SprintfNode{
+			Arguments: args,
+		}, nil
+	case "sqrt":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SqrtNode{
+			Argument: arg,
+		}, nil
+	case "starts_with":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &StartsWithNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "stddev":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &StddevNode{
+			Argument: arg,
+		}, nil
+	case "sum":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SumNode{
+			Argument: arg,
+		}, nil
+	case "sum_by":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SumByNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "symmetric_difference":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SymmetricDifferenceNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "take":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TakeNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "time_diff":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TimeDiffNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "time_part":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TimePartNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
+	case "title_case":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TitleCaseNode{
+			Argument: arg,
+		}, nil
+	case "to_array":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ToArrayNode{
+			Argument: arg,
+		}, nil
+	case "to_boolean":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &ReverseNode{
+		return &ToBooleanNode{
 			Argument: arg,
 		}, nil
-	case "sort":
+	case "to_epoch":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &SortNode{
+		return &ToEpochNode{
 			Argument: arg,
 		}, nil
-	case "sort_by":
-		arg1, arg2, err := p.function2ExpArg(name)
+	case "to_epoch_millis":
+		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &SortByNode{
-			Arguments: [2]Node{arg1, arg2},
+		return &ToEpochMillisNode{
+			Argument: arg,
 		}, nil
-	case "split":
-		arg1, arg2, arg3, err := p.function2To3Arg(name)
+	case "to_integer":
+		arg1, arg2, err := p.function1To2Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		if arg3 == nil {
-			return &SplitNode{
-				Arguments: [2]Node{arg1, arg2},
+		if arg2 == nil {
+			return &ToIntegerNode{
+				Argument: arg1,
 			}, nil
 		}
 
-		return &SplitCountNode{
-			Arguments: [3]Node{arg1, arg2, arg3},
+		return &ToIntegerModeNode{
+			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "starts_with":
-		arg1, arg2, err := p.function2Arg(name)
+	case "to_json":
+		arg1, arg2, err := p.function1To2Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &StartsWithNode{
+		if arg2 == nil {
+			return &ToJSONNode{
+				Argument: arg1,
+			}, nil
+		}
+
+		return &ToJSONOptionsNode{
 			Arguments: [2]Node{arg1, arg2},
 		}, nil
-	case "sum":
+	case "to_number":
 		arg, err := p.function1Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &SumNode{
+		return &ToNumberNode{
 			Argument: arg,
 		}, nil
-	case "to_array":
-		arg, err := p.function1Arg(name)
+	case "to_object":
+		arg1, arg2, err := p.function1To2Arg(name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &ToArrayNode{
-			Argument: arg,
-		}, nil
-	case "to_number":
-		arg, err := p.function1Arg(name)
-		if err != nil {
-			return nil, err
+		if arg2 == nil {
+			return &ToObjectNode{
+				Argument: arg1,
+			}, nil
 		}
 
-		return &ToNumberNode{
-			Argument: arg,
+		return &ToObjectKeyNode{
+			Arguments: [2]Node{arg1, arg2},
 		}, nil
 	case "to_string":
 		arg, err := p.function1Arg(name)
@@ -940,6 +2219,30 @@ func (p *parser) function() (Node, error) {
 		return &TrimRightNode{
 			Arguments: [2]Node{arg1, arg2},
 		}, nil
+	case "truncate":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &TruncateNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &TruncateSuffixNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "truncate_time":
+		arg1, arg2, err := p.function2Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TruncateTimeNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
 	case "type":
 		arg, err := p.function1Arg(name)
 		if err != nil {
@@ -949,6 +2252,33 @@ func (p *parser) function() (Node, error) {
 		return &TypeNode{
 			Argument: arg,
 		}, nil
+	case "union":
+		args, err := p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &UnionNode{
+			Arguments: args,
+		}, nil
+	case "unique":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &UniqueNode{
+			Argument: arg,
+		}, nil
+	case "unique_by":
+		arg1, arg2, err := p.function2ExpArg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &UniqueByNode{
+			Arguments: [2]Node{arg1, arg2},
+		}, nil
 	case "upper":
 		arg, err := p.function1Arg(name)
 		if err != nil {
@@ -958,6 +2288,24 @@ func (p *parser) function() (Node, error) {
 		return &UpperNode{
 			Argument: arg,
 		}, nil
+	case "url_decode":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &URLDecodeNode{
+			Argument: arg,
+		}, nil
+	case "url_encode":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &URLEncodeNode{
+			Argument: arg,
+		}, nil
 	case "values":
 		arg, err := p.function1Arg(name)
 		if err != nil {
@@ -967,6 +2315,39 @@ func (p *parser) function() (Node, error) {
 		return &ValuesNode{
 			Argument: arg,
 		}, nil
+	case "variance":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &VarianceNode{
+			Argument: arg,
+		}, nil
+	case "window":
+		arg1, arg2, arg3, err := p.function2To3Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if arg3 == nil {
+			return &WindowNode{
+				Arguments: [2]Node{arg1, arg2},
+			}, nil
+		}
+
+		return &WindowStepNode{
+			Arguments: [3]Node{arg1, arg2, arg3},
+		}, nil
+	case "words":
+		arg, err := p.function1Arg(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &WordsNode{
+			Argument: arg,
+		}, nil
 	case "zip":
 		args, err := p.functionVarArg(name)
 		if err != nil {
@@ -981,6 +2362,14 @@ func (p *parser) function() (Node, error) {
 	return nil, &UnknownFunctionError{name}
 }
 
+func (p *parser) function0Arg(name string) error {
+	if p.curr.Type != lexer.CloseParenToken {
+		return &InvalidFunctionCallError{name}
+	}
+
+	return p.advance()
+}
+
 func (p *parser) function1Arg(name string) (Node, error) {
 	if p.curr.Type == lexer.CloseParenToken {
 		return nil, &InvalidFunctionCallError{name}
@@ -1327,14 +2716,136 @@ func (p *parser) function2To4Arg(name string) (Node, Node, Node, Node, error) {
 	}
 
 	if p.curr.Type != lexer.CloseParenToken {
-		return nil, nil, nil, nil, &unexpectedTokenError{p.curr.Value}
+		return nil, nil, nil, nil, &unexpectedTokenError{p.curr.Value}
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return arg1, arg2, arg3, arg4, nil
+}
+
+func (p *parser) function3Arg(name string) (Node, Node, Node, error) {
+	if p.curr.Type == lexer.CloseParenToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	arg1, err := p.expression(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if p.curr.Type == lexer.CloseParenToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	if p.curr.Type != lexer.CommaToken {
+		return nil, nil, nil, &unexpectedTokenError{p.curr.Value}
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	arg2, err := p.expression(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if p.curr.Type == lexer.CloseParenToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	if p.curr.Type != lexer.CommaToken {
+		return nil, nil, nil, &unexpectedTokenError{p.curr.Value}
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	arg3, err := p.expression(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if p.curr.Type == lexer.CommaToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	if p.curr.Type != lexer.CloseParenToken {
+		return nil, nil, nil, &unexpectedTokenError{p.curr.Value}
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return arg1, arg2, arg3, nil
+}
+
+func (p *parser) function3ExpArg(name string) (Node, Node, Node, error) {
+	if p.curr.Type == lexer.CloseParenToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	arg1, err := p.expression(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if p.curr.Type == lexer.CloseParenToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	if p.curr.Type != lexer.CommaToken {
+		return nil, nil, nil, &unexpectedTokenError{p.curr.Value}
+	}
+
+	if p.next.Type != lexer.ExpressionToken {
+		return nil, nil, nil, &InvalidFunctionArgumentError{name, "expression"}
+	}
+
+	if err := p.advance2(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	arg2, err := p.expression(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if p.curr.Type == lexer.CloseParenToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	if p.curr.Type != lexer.CommaToken {
+		return nil, nil, nil, &unexpectedTokenError{p.curr.Value}
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	arg3, err := p.expression(1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if p.curr.Type == lexer.CommaToken {
+		return nil, nil, nil, &InvalidFunctionCallError{name}
+	}
+
+	if p.curr.Type != lexer.CloseParenToken {
+		return nil, nil, nil, &unexpectedTokenError{p.curr.Value}
 	}
 
 	if err := p.advance(); err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	return arg1, arg2, arg3, arg4, nil
+	return arg1, arg2, arg3, nil
 }
 
 func (p *parser) function3To4Arg(name string) (Node, Node, Node, Node, error) {
@@ -1417,9 +2928,66 @@ func (p *parser) function3To4Arg(name string) (Node, Node, Node, Node, error) {
 	return arg1, arg2, arg3, arg4, nil
 }
 
-func (p *parser) functionNotNull() (Node, error) {
+func (p *parser) functionDefinition(scope *functionScope) (string, *FunctionDefinition, error) {
+	name := p.next.Value
+
+	if err := p.advance2(); err != nil {
+		return "", nil, err
+	}
+
+	if p.curr.Type != lexer.OpenParenToken {
+		return "", nil, &unexpectedTokenError{p.curr.Value}
+	}
+
+	if err := p.advance(); err != nil {
+		return "", nil, err
+	}
+
+	var parameters []string
+	for p.curr.Type != lexer.CloseParenToken {
+		if p.curr.Type != lexer.VariableToken || slices.Contains(parameters, p.curr.Value) {
+			return "", nil, &unexpectedTokenError{p.curr.Value}
+		}
+
+		parameters = append(parameters, p.curr.Value)
+
+		if p.next.Type == lexer.CommaToken {
+			if err := p.advance2(); err != nil {
+				return "", nil, err
+			}
+		} else if err := p.advance(); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if p.next.Type != lexer.AssignToken {
+		return "", nil, &unexpectedTokenError{p.next.Value}
+	}
+
+	if err := p.advance2(); err != nil {
+		return "", nil, err
+	}
+
+	scope.functions[name] = len(parameters)
+
+	p.setFunctions(scope)
+	letBinding := p.setLetBinding(true)
+	body, err := p.expression(1)
+	p.setLetBinding(letBinding)
+	p.setFunctions(scope.parent)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, &FunctionDefinition{
+		Parameters: parameters,
+		Body:       body,
+	}, nil
+}
+
+func (p *parser) functionNotNull(name string) (Node, error) {
 	if p.curr.Type == lexer.CloseParenToken {
-		return nil, &InvalidFunctionCallError{"not_null"}
+		return nil, &InvalidFunctionCallError{name}
 	}
 
 	node, err := p.expression(1)
@@ -1724,28 +3292,50 @@ func (p *parser) index(child Node) (Node, bool, error) {
 }
 
 func (p *parser) let() (Node, error) {
+	outer := p.functions
+	defer p.setFunctions(outer)
+
+	scope := outer.new()
+	p.declareFunctions(scope)
+
 	variables := make(map[string]Node)
+	var functions map[string]*FunctionDefinition
 	for {
-		if p.curr.Type != lexer.VariableToken {
-			return nil, &unexpectedTokenError{p.curr.Value}
-		}
+		if p.curr.Type == lexer.UnquotedIdentifierToken && p.curr.Value == "fn" && p.next.Type == lexer.VariableToken {
+			name, function, err := p.functionDefinition(scope)
+			if err != nil {
+				return nil, err
+			}
 
-		if p.next.Type != lexer.AssignToken {
-			return nil, &unexpectedTokenError{p.next.Value}
-		}
+			if functions == nil {
+				functions = make(map[string]*FunctionDefinition)
+			}
+
+			functions[name] = function
+		} else {
+			if p.curr.Type != lexer.VariableToken {
+				return nil, &unexpectedTokenError{p.curr.Value}
+			}
 
-		variable := p.curr.Value
+			if p.next.Type != lexer.AssignToken {
+				return nil, &unexpectedTokenError{p.next.Value}
+			}
 
-		if err := p.advance2(); err != nil {
-			return nil, err
-		}
+			variable := p.curr.Value
 
-		node, err := p.expression(1)
-		if err != nil {
-			return nil, err
-		}
+			if err := p.advance2(); err != nil {
+				return nil, err
+			}
+
+			letBinding := p.setLetBinding(true)
+			node, err := p.expression(1)
+			p.setLetBinding(letBinding)
+			if err != nil {
+				return nil, err
+			}
 
-		variables[variable] = node
+			variables[variable] = node
+		}
 
 		if p.curr.Type == lexer.InToken {
 			if err := p.advance(); err != nil {
@@ -1764,6 +3354,7 @@ func (p *parser) let() (Node, error) {
 		}
 	}
 
+	p.setFunctions(scope)
 	child, err := p.expression(1)
 	if err != nil {
 		return nil, err
@@ -1771,10 +3362,28 @@ func (p *parser) let() (Node, error) {
 
 	return &DefineVariables{
 		Variables: variables,
+		Functions: functions,
 		Child:     child,
 	}, nil
 }
 
+func (p *parser) notIn() error {
+	if p.curr.Type != lexer.UnquotedIdentifierToken || p.curr.Value != "not" || p.next.Type != lexer.InToken {
+		return nil
+	}
+
+	if err := p.advance(); err != nil {
+		return err
+	}
+
+	p.setCurrent(lexer.Token{
+		Type:  lexer.NotInToken,
+		Value: "not in",
+	})
+
+	return nil
+}
+
 func (p *parser) parse() (Node, error) {
 	node, err := p.expression(1)
 	if err != nil {
@@ -1936,7 +3545,9 @@ func (p *parser) primaryExpression() (Node, error) {
 			return nil, err
 		}
 
+		letBinding := p.setLetBinding(false)
 		node, err = p.expression(1)
+		p.setLetBinding(letBinding)
 		if err != nil {
 			return nil, err
 		}
@@ -2033,6 +3644,17 @@ func (p *parser) primaryExpression() (Node, error) {
 		return &NegateNode{
 			Child: child,
 		}, nil
+	case lexer.TemplateLiteralToken:
+		child, err := parseTemplateLiteral(p.curr.Value, p.functions)
+		if err != nil {
+			return nil, err
+		}
+
+		node = child
+
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
 	case lexer.UnquotedIdentifierToken:
 		if p.next.Type == lexer.OpenParenToken {
 			node, err = p.function()
@@ -2049,12 +3671,19 @@ func (p *parser) primaryExpression() (Node, error) {
 			}
 		}
 	case lexer.VariableToken:
-		node = &VariableNode{
-			Name: p.curr.Value,
-		}
+		if p.next.Type == lexer.OpenParenToken {
+			node, err = p.userFunction()
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			node = &VariableNode{
+				Name: p.curr.Value,
+			}
 
-		if err := p.advance(); err != nil {
-			return nil, err
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
 		}
 	default:
 		return nil, &unexpectedTokenError{p.curr.Value}
@@ -2101,6 +3730,19 @@ func (p *parser) projection(prec int) (Node, error) {
 				return nil, err
 			}
 
+			node, err = p.expression(prec)
+			if err != nil {
+				return nil, err
+			}
+		case lexer.VariableToken:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			if p.next.Type != lexer.OpenParenToken {
+				return nil, &unexpectedTokenError{p.curr.Value}
+			}
+
 			node, err = p.expression(prec)
 			if err != nil {
 				return nil, err
@@ -2152,6 +3794,10 @@ func (p *parser) projection(prec int) (Node, error) {
 		return nil, nil
 	}
 
+	if err := p.notIn(); err != nil {
+		return nil, err
+	}
+
 	newPrec := precedence(p.curr.Type)
 	for newPrec > prec {
 		switch p.curr.Type {
@@ -2190,6 +3836,19 @@ func (p *parser) projection(prec int) (Node, error) {
 					return nil, err
 				}
 
+				node, err = p.expression(newPrec)
+				if err != nil {
+					return nil, err
+				}
+			case lexer.VariableToken:
+				if err := p.advance(); err != nil {
+					return nil, err
+				}
+
+				if p.next.Type != lexer.OpenParenToken {
+					return nil, &unexpectedTokenError{p.curr.Value}
+				}
+
 				node, err = p.expression(newPrec)
 				if err != nil {
 					return nil, err
@@ -2249,6 +3908,10 @@ func (p *parser) projection(prec int) (Node, error) {
 			return nil, &unexpectedTokenError{p.curr.Value}
 		}
 
+		if err := p.notIn(); err != nil {
+			return nil, err
+		}
+
 		newPrec = precedence(p.curr.Type)
 	}
 
@@ -2256,17 +3919,31 @@ func (p *parser) projection(prec int) (Node, error) {
 }
 
 func (p *parser) selectArray(child Node) (Node, error) {
-	var fields []Node
+	defer p.setLetBinding(p.setLetBinding(false))
+
+	var entries []SelectArrayEntry
+	var spread bool
 	for {
+		var entry SelectArrayEntry
+		if p.curr.Type == lexer.SpreadToken {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			entry.Spread = true
+			spread = true
+		}
+
 		field, err := p.expression(1)
 		if err != nil {
 			return nil, err
 		}
 
+		entry.Field = field
+		entries = append(entries, entry)
+
 		switch p.curr.Type {
 		case lexer.CommaToken:
-			fields = append(fields, field)
-
 			if err := p.advance(); err != nil {
 				return nil, err
 			}
@@ -2275,7 +3952,20 @@ func (p *parser) selectArray(child Node) (Node, error) {
 				return nil, err
 			}
 
-			if len(fields) == 0 {
+			if spread {
+				if child == nil {
+					return &SelectArrayEntriesCurrentNode{
+						Entries: entries,
+					}, nil
+				}
+
+				return &SelectArrayEntriesNode{
+					Child:   child,
+					Entries: entries,
+				}, nil
+			}
+
+			if len(entries) == 1 {
 				if child == nil {
 					return &SelectArraySingleCurrentNode{
 						Field: field,
@@ -2288,7 +3978,10 @@ func (p *parser) selectArray(child Node) (Node, error) {
 				}, nil
 			}
 
-			fields = append(fields, field)
+			fields := make([]Node, len(entries))
+			for i, entry := range entries {
+				fields[i] = entry.Field
+			}
 
 			if child == nil {
 				return &SelectArrayCurrentNode{
@@ -2307,26 +4000,62 @@ func (p *parser) selectArray(child Node) (Node, error) {
 }
 
 func (p *parser) selectObject(child Node) (Node, error) {
+	defer p.setLetBinding(p.setLetBinding(false))
+
 	fields := make(map[string]Node)
+	var entries []SelectObjectEntry
+	var dynamic bool
 	for {
 		var key string
+		var entry SelectObjectEntry
 		switch p.curr.Type {
+		case lexer.OpenSqBraceToken:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+
+			node, err := p.expression(1)
+			if err != nil {
+				return nil, err
+			}
+
+			if p.curr.Type != lexer.CloseSqBraceToken {
+				return nil, &unexpectedTokenError{p.curr.Value}
+			}
+
+			entry.Key = node
+			dynamic = true
 		case lexer.QuotedIdentifierToken:
 			var err error
 			key, err = parseQuotedIdentifier(p.curr.Value)
 			if err != nil {
 				return nil, err
 			}
+		case lexer.SpreadToken:
+			entry.Spread = true
+			dynamic = true
 		case lexer.UnquotedIdentifierToken:
 			key = p.curr.Value
 		}
 
-		if p.next.Type != lexer.ColonToken {
-			return nil, &unexpectedTokenError{p.next.Value}
-		}
+		if entry.Spread {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		} else {
+			if p.next.Type != lexer.ColonToken {
+				return nil, &unexpectedTokenError{p.next.Value}
+			}
 
-		if err := p.advance2(); err != nil {
-			return nil, err
+			if err := p.advance2(); err != nil {
+				return nil, err
+			}
+
+			if entry.Key == nil {
+				entry.Key = &ValueNode{
+					Value: key,
+				}
+			}
 		}
 
 		field, err := p.expression(1)
@@ -2334,6 +4063,9 @@ func (p *parser) selectObject(child Node) (Node, error) {
 			return nil, err
 		}
 
+		entry.Field = field
+		entries = append(entries, entry)
+
 		switch p.curr.Type {
 		case lexer.CommaToken:
 			fields[key] = field
@@ -2346,6 +4078,19 @@ func (p *parser) selectObject(child Node) (Node, error) {
 				return nil, err
 			}
 
+			if dynamic {
+				if child == nil {
+					return &SelectObjectEntriesCurrentNode{
+						Entries: entries,
+					}, nil
+				}
+
+				return &SelectObjectEntriesNode{
+					Child:   child,
+					Entries: entries,
+				}, nil
+			}
+
 			if len(fields) == 0 {
 				if child == nil {
 					return &SelectObjectSingleCurrentNode{
@@ -2381,6 +4126,79 @@ func (p *parser) setCurrent(tok lexer.Token) {
 	p.curr = tok
 }
 
+func (p *parser) setFunctions(functions *functionScope) *functionScope {
+	previous := p.functions
+	p.functions = functions
+	return previous
+}
+
+func (p *parser) setLetBinding(letBinding bool) bool {
+	previous := p.letBinding
+	p.letBinding = letBinding
+	return previous
+}
+
+func (p *parser) userFunction() (Node, error) {
+	defer p.setLetBinding(p.setLetBinding(false))
+
+	name := p.curr.Value
+
+	arity, ok := p.functions.arity(name)
+	if !ok {
+		return nil, &UnknownFunctionError{name}
+	}
+
+	if err := p.advance2(); err != nil {
+		return nil, err
+	}
+
+	var args []Node
+	if p.curr.Type == lexer.CloseParenToken {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		args, err = p.functionVarArg(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args) != arity {
+		return nil, &InvalidFunctionCallError{name}
+	}
+
+	return &UserFunctionNode{
+		Name:      name,
+		Arguments: args,
+	}, nil
+}
+
+func compileRegexp(node Node) (Node, error) {
+	value, ok := node.(*ValueNode)
+	if !ok {
+		return node, nil
+	}
+
+	pattern, ok := value.Value.(string)
+	if !ok {
+		return node, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, &InvalidRegexpError{
+			Pattern: pattern,
+			err:     err,
+		}
+	}
+
+	return &RegexpNode{
+		Value: re,
+	}, nil
+}
+
 func parseJSONLiteral(s string) (any, error) {
 	v := strings.ReplaceAll(s[1:len(s)-1], "\\`", "`")
 	if len(v) == 0 {
@@ -2564,3 +4382,77 @@ func parseStringLiteral(s string) (string, error) {
 		v = v[i+1:]
 	}
 }
+
+func parseTemplateLiteral(s string, functions *functionScope) (Node, error) {
+	literals, expressions, err := lexer.TemplateParts(s)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]Node, 0, len(literals)+len(expressions))
+	for i, literal := range literals {
+		if literal != "" {
+			text, err := parseTemplateText(literal)
+			if err != nil {
+				return nil, err
+			}
+
+			parts = append(parts, &ValueNode{
+				Value: text,
+			})
+		}
+
+		if i < len(expressions) {
+			node, err := parse(expressions[i], functions)
+			if err != nil {
+				return nil, err
+			}
+
+			parts = append(parts, node)
+		}
+	}
+
+	return &TemplateNode{
+		Parts: parts,
+	}, nil
+}
+
+func parseTemplateText(s string) (string, error) {
+	i := strings.IndexByte(s, '\\')
+	if i == -1 {
+		return s, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i != -1 {
+		b.WriteString(s[:i])
+		if i+1 == len(s) {
+			return "", &invalidEscapeError{
+				s: s[i:],
+			}
+		}
+
+		switch c := s[i+1]; c {
+		case '$', '\\', '`':
+			b.WriteByte(c)
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		default:
+			_, sz := utf8.DecodeRuneInString(s[i+1:])
+			return "", &invalidEscapeError{
+				s: s[i : i+1+sz],
+			}
+		}
+
+		s = s[i+2:]
+		i = strings.IndexByte(s, '\\')
+	}
+
+	b.WriteString(s)
+	return b.String(), nil
+}
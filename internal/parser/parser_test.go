@@ -1,6 +1,52 @@
 package parser
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseComments(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		commented string
+		plain     string
+	}
+
+	tests := []test{
+		{"a # comment", "a"},
+		{"# leading\na.b", "a.b"},
+		{"people\n  # only adults\n  [?age > `25`] # filter\n  .name", "people[?age > `25`].name"},
+		{"{\n  # first\n  n: x, # trailing\n  m: y\n}", "{n: x, m: y}"},
+		{"a # ignored ' ` \" (\n| b", "a | b"},
+		{"let $x = `1` # binding\nin $x", "let $x = `1` in $x"},
+		{"'#text' # comment", "'#text'"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.commented, func(t *testing.T) {
+			t.Parallel()
+
+			commented, err := Parse(test.commented)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", test.commented, err)
+			}
+
+			plain, err := Parse(test.plain)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", test.plain, err)
+			}
+
+			if commented.String() != plain.String() {
+				t.Errorf("Parse(%q).String() = %s, want %s", test.commented, commented.String(), plain.String())
+			}
+
+			if !reflect.DeepEqual(commented, plain) {
+				t.Errorf("Parse(%q) = %#v, want %#v", test.commented, commented, plain)
+			}
+		})
+	}
+}
 
 func TestParseJSONLiteral(t *testing.T) {
 	t.Parallel()
@@ -56,6 +102,10 @@ func TestParseStringLiteral(t *testing.T) {
 
 func FuzzParser(f *testing.F) {
 	f.Add("a[].b[?c == 'X'] | {x: join(', ', @)}")
+	f.Add("$`Hello ${name} \\${x}`")
+	f.Add("{...@, [name]: [...a, ...b]}")
+	f.Add("a # comment\n| b")
+	f.Add("let fn $f($x) = $x * `2` in a[*].$f(@)")
 
 	f.Fuzz(func(t *testing.T, expression string) {
 		t.Parallel()
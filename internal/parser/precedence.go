@@ -8,39 +8,45 @@ func precedence(t lexer.TokenType) int {
 		return 2
 	case lexer.IfToken:
 		return 3
-	case lexer.OrToken:
+	case lexer.NullCoalesceToken:
 		return 4
-	case lexer.AndToken:
+	case lexer.OrToken:
 		return 5
+	case lexer.AndToken:
+		return 6
 	case lexer.EqualToken,
 		lexer.GreaterToken,
 		lexer.GreaterOrEqualToken,
+		lexer.InToken,
 		lexer.LessToken,
 		lexer.LessOrEqualToken,
-		lexer.NotEqualToken:
-		return 6
+		lexer.MatchToken,
+		lexer.NotEqualToken,
+		lexer.NotInToken,
+		lexer.NotMatchToken:
+		return 7
 	case lexer.AddToken,
 		lexer.SubtractToken:
-		return 7
+		return 8
 	case lexer.AsteriskToken,
 		lexer.DivideToken,
 		lexer.IntegerDivideToken,
 		lexer.ModuloToken,
 		lexer.MultiplyToken:
-		return 8
-	case lexer.FlattenToken:
 		return 9
-	case lexer.ObjectWildcardToken:
+	case lexer.FlattenToken:
 		return 10
-	case lexer.FilterToken:
+	case lexer.ObjectWildcardToken:
 		return 11
-	case lexer.DotToken:
+	case lexer.FilterToken:
 		return 12
-	case lexer.NotToken:
+	case lexer.DotToken:
 		return 13
+	case lexer.NotToken:
+		return 14
 	case lexer.ArrayWildcardToken,
 		lexer.OpenSqBraceToken:
-		return 14
+		return 15
 	default:
 		return 0
 	}
@@ -0,0 +1,29 @@
+package parser
+
+type functionScope struct {
+	parent    *functionScope
+	functions map[string]int
+}
+
+func (s *functionScope) arity(function string) (int, bool) {
+	if s == nil {
+		return 0, false
+	}
+
+	if arity, ok := s.functions[function]; ok {
+		return arity, true
+	}
+
+	if s.parent != nil {
+		return s.parent.arity(function)
+	}
+
+	return 0, false
+}
+
+func (s *functionScope) new() *functionScope {
+	return &functionScope{
+		parent:    s,
+		functions: make(map[string]int),
+	}
+}
@@ -8,14 +8,22 @@ import (
 	"github.com/woodsbury/jmespath/internal/parser"
 )
 
+// MaxArrayLength is the maximum length of an array that can be generated by
+// functions such as range and repeat.
+const MaxArrayLength = evaluator.MaxArrayLength
+
+// MaxStringLength is the maximum length in bytes of a string that can be
+// generated by functions such as repeat_string and indent.
+const MaxStringLength = evaluator.MaxStringLength
+
 // Search evaluates expression with data and returns the result.
-func Search(expression string, data any) (any, error) {
+func Search(expression string, data any, opts ...Option) (any, error) {
 	node, err := parser.Parse(expression)
 	if err != nil {
 		return nil, parseError(expression, err)
 	}
 
-	result, err := evaluator.Evaluate(node, data)
+	result, err := evaluator.Evaluate(node, data, evaluateOptions(opts))
 	if err != nil {
 		return nil, evaluateError(err)
 	}
@@ -25,7 +33,8 @@ func Search(expression string, data any) (any, error) {
 
 // Expression represents a compiled expression.
 type Expression struct {
-	node parser.Node
+	node      parser.Node
+	assignErr error
 }
 
 // Compile compiles expression and, if successful, returns an [Expression] that
@@ -37,10 +46,27 @@ func Compile(expression string) (*Expression, error) {
 	}
 
 	return &Expression{
-		node: node,
+		node:      node,
+		assignErr: evaluator.Assignable(node),
 	}, nil
 }
 
+// CompileAssignable is like [Compile] but also returns an error wrapping
+// [ErrNotAssignable] if the expression does not select locations that can be
+// changed by [Expression.Set], [Expression.Update] and [Expression.Delete].
+func CompileAssignable(expression string) (*Expression, error) {
+	e, err := Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.assignErr != nil {
+		return nil, evaluateError(e.assignErr)
+	}
+
+	return e, nil
+}
+
 // MustCompile is like [Compile] but panics if the expression cannot be
 // compiled.
 func MustCompile(expression string) *Expression {
@@ -50,14 +76,79 @@ func MustCompile(expression string) *Expression {
 	}
 
 	return &Expression{
-		node: node,
+		node:      node,
+		assignErr: evaluator.Assignable(node),
 	}
 }
 
 // Search evaluates the compiled expression against data and returns the
 // result.
-func (e *Expression) Search(data any) (any, error) {
-	result, err := evaluator.Evaluate(e.node, data)
+func (e *Expression) Search(data any, opts ...Option) (any, error) {
+	result, err := evaluator.Evaluate(e.node, data, evaluateOptions(opts))
+	if err != nil {
+		return nil, evaluateError(err)
+	}
+
+	return result, nil
+}
+
+// SearchPaths evaluates the compiled expression against data and returns each
+// result along with its location within data. Projections produce a [Match]
+// for each of their elements rather than one for the array they construct.
+func (e *Expression) SearchPaths(data any, opts ...Option) ([]Match, error) {
+	locations, err := evaluator.Locate(e.node, data, evaluateOptions(opts))
+	if err != nil {
+		return nil, evaluateError(err)
+	}
+
+	matches := make([]Match, len(locations))
+	for i, location := range locations {
+		matches[i] = newMatch(location)
+	}
+
+	return matches, nil
+}
+
+// Delete returns a copy of data with every object key or array element
+// selected by the compiled expression removed. The input data is not modified.
+// An error wrapping [ErrNotAssignable] is returned if the expression does not
+// select locations within data.
+func (e *Expression) Delete(data any, opts ...Option) (any, error) {
+	if e.assignErr != nil {
+		return nil, evaluateError(e.assignErr)
+	}
+
+	result, err := evaluator.Delete(e.node, data, evaluateOptions(opts))
+	if err != nil {
+		return nil, evaluateError(err)
+	}
+
+	return result, nil
+}
+
+// Set returns a copy of data in which every location selected by the compiled
+// expression is replaced with value. The input data is not modified. Missing
+// object fields are created, except within a projection, where only the
+// elements for which the projection selects a non-null value are changed. An
+// error wrapping [ErrNotAssignable] is returned if the expression does not
+// select locations within data, for example if it calls a function; use
+// [CompileAssignable] to detect this when compiling.
+func (e *Expression) Set(data, value any, opts ...Option) (any, error) {
+	return e.Update(data, func(any) any {
+		return value
+	}, opts...)
+}
+
+// Update returns a copy of data in which every location selected by the
+// compiled expression is replaced with the result of calling fn with its
+// current value, in the same way as [Expression.Set]. The input data is not
+// modified.
+func (e *Expression) Update(data any, fn func(old any) any, opts ...Option) (any, error) {
+	if e.assignErr != nil {
+		return nil, evaluateError(e.assignErr)
+	}
+
+	result, err := evaluator.Update(e.node, data, fn, evaluateOptions(opts))
 	if err != nil {
 		return nil, evaluateError(err)
 	}
@@ -66,6 +157,10 @@ func (e *Expression) Search(data any) (any, error) {
 }
 
 func evaluateError(err error) error {
+	if errors.Is(err, evaluator.ErrCallDepthExceeded) {
+		return &callDepthExceededError{err.Error()}
+	}
+
 	if errors.Is(err, evaluator.ErrInvalidType) {
 		return &invalidTypeError{err.Error()}
 	}
@@ -82,6 +177,10 @@ func evaluateError(err error) error {
 		return &notANumberError{}
 	}
 
+	if errors.Is(err, evaluator.ErrNotAssignable) {
+		return &notAssignableError{err.Error()}
+	}
+
 	if err, ok := err.(*evaluator.UndefinedVariableError); ok {
 		return &undefinedVariableError{err.Variable}
 	}
@@ -98,6 +197,10 @@ func parseError(expression string, err error) error {
 		return &invalidFunctionCallError{err.Function}
 	}
 
+	if err, ok := err.(*parser.InvalidRegexpError); ok {
+		return &invalidValueError{err.Error()}
+	}
+
 	if _, ok := err.(*parser.InvalidSliceStepError); ok {
 		return &invalidSliceStepError{}
 	}
@@ -0,0 +1,122 @@
+package jmespath
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/woodsbury/jmespath/internal/evaluator"
+)
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Match is a single result of [Expression.SearchPaths] together with the
+// location in the data that it was found at.
+type Match struct {
+	// Value is the result.
+	Value any
+
+	// Pointer is the location of Value as a JSON Pointer (RFC 6901). It is
+	// empty if Value is derived.
+	Pointer string
+
+	// Path is the location of Value as a JMESPath expression that selects it.
+	// It is empty if Value is derived.
+	Path string
+
+	// Derived reports whether Value was computed, for example by a function
+	// or arithmetic, rather than found in the data.
+	Derived bool
+
+	// Sources holds the locations of the inputs that a derived Value was
+	// computed from. It is best-effort: inputs whose locations cannot be
+	// determined, such as the values visited by an expression reference, are
+	// omitted.
+	Sources []Match
+}
+
+func newMatch(location evaluator.Location) Match {
+	if location.Derived {
+		m := Match{
+			Value:   location.Value,
+			Derived: true,
+		}
+
+		if len(location.Sources) > 0 {
+			m.Sources = make([]Match, len(location.Sources))
+			for i, source := range location.Sources {
+				m.Sources[i] = newMatch(source)
+			}
+		}
+
+		return m
+	}
+
+	return Match{
+		Value:   location.Value,
+		Pointer: jsonPointer(location.Path),
+		Path:    pathExpression(location.Path),
+	}
+}
+
+func jsonPointer(path []any) string {
+	var b strings.Builder
+	for _, p := range path {
+		b.WriteByte('/')
+		switch p := p.(type) {
+		case int:
+			b.WriteString(strconv.Itoa(p))
+		case string:
+			b.WriteString(pointerEscaper.Replace(p))
+		}
+	}
+
+	return b.String()
+}
+
+func pathExpression(path []any) string {
+	if len(path) == 0 {
+		return "@"
+	}
+
+	var b strings.Builder
+	for i, p := range path {
+		switch p := p.(type) {
+		case int:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(p))
+			b.WriteByte(']')
+		case string:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+
+			if isIdentifier(p) {
+				b.WriteString(p)
+			} else {
+				q, _ := json.Marshal(p)
+				b.Write(q)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+func isIdentifier(s string) bool {
+	switch s {
+	case "", "in", "let":
+		return false
+	}
+
+	for i, c := range s {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
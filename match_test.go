@@ -0,0 +1,189 @@
+package jmespath
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSearchPaths(t *testing.T) {
+	t.Parallel()
+
+	given := `{
+		"a": {"b": {"c": 1}},
+		"items": [
+			{"name": "x", "price": 1, "tags": ["p", "q"]},
+			{"name": "y", "price": 2, "tags": ["r"]},
+			{"name": "z", "tags": []}
+		],
+		"nested": [[1, 2], 3, null, [4]],
+		"map": {"k1": {"v": 1}, "k2": {"v": 2}},
+		"odd keys": {"a/b": {"c~d": true}},
+		"let": {"in": [{"not": 1}]}
+	}`
+
+	tests := []struct {
+		expression string
+		matches    []string
+	}{
+		{
+			expression: "a.b.c",
+			matches:    []string{"/a/b/c a.b.c 1"},
+		},
+		{
+			expression: "a.b",
+			matches:    []string{`/a/b a.b {"c":1}`},
+		},
+		{
+			expression: "a.missing",
+			matches:    []string{},
+		},
+		{
+			expression: "a | $.a.b.c",
+			matches:    []string{"/a/b/c a.b.c 1"},
+		},
+		{
+			expression: "items[1].name",
+			matches:    []string{`/items/1/name items[1].name "y"`},
+		},
+		{
+			expression: "items[-1].name",
+			matches:    []string{`/items/2/name items[2].name "z"`},
+		},
+		{
+			expression: "items[*].price",
+			matches: []string{
+				"/items/0/price items[0].price 1",
+				"/items/1/price items[1].price 2",
+			},
+		},
+		{
+			expression: "items[1:].name",
+			matches: []string{
+				`/items/1/name items[1].name "y"`,
+				`/items/2/name items[2].name "z"`,
+			},
+		},
+		{
+			expression: "items[?price > `1`].name",
+			matches:    []string{`/items/1/name items[1].name "y"`},
+		},
+		{
+			expression: "items[?price > `1`]",
+			matches:    []string{`/items/1 items[1] {"name":"y","price":2,"tags":["r"]}`},
+		},
+		{
+			expression: "items[].tags[]",
+			matches: []string{
+				`/items/0/tags/0 items[0].tags[0] "p"`,
+				`/items/0/tags/1 items[0].tags[1] "q"`,
+				`/items/1/tags/0 items[1].tags[0] "r"`,
+			},
+		},
+		{
+			expression: "nested[]",
+			matches: []string{
+				"/nested/0/0 nested[0][0] 1",
+				"/nested/0/1 nested[0][1] 2",
+				"/nested/1 nested[1] 3",
+				"/nested/3/0 nested[3][0] 4",
+			},
+		},
+		{
+			expression: "map.*.v",
+			matches: []string{
+				"/map/k1/v map.k1.v 1",
+				"/map/k2/v map.k2.v 2",
+			},
+		},
+		{
+			expression: "items[*].name | [1]",
+			matches:    []string{`/items/1/name items[1].name "y"`},
+		},
+		{
+			expression: `"odd keys"."a/b"."c~d"`,
+			matches:    []string{`/odd keys/a~1b/c~0d "odd keys"."a/b"."c~d" true`},
+		},
+		{
+			expression: `"let"."in"[0].not`,
+			matches:    []string{`/let/in/0/not "let"."in"[0].not 1`},
+		},
+		{
+			expression: "sum(items[*].price)",
+			matches:    []string{"derived 3 /items/0/price /items/1/price"},
+		},
+		{
+			expression: "abs(sum(items[*].price))",
+			matches:    []string{"derived 3 /items/0/price /items/1/price"},
+		},
+		{
+			expression: "sum(map(&(@ * `2`), items[*].price))",
+			matches:    []string{"derived 6 /items/0/price /items/1/price"},
+		},
+		{
+			expression: "max_by(items[:2], &price).name",
+			matches:    []string{`derived "y" /items/0 /items/1`},
+		},
+		{
+			expression: "a.b.c + `1`",
+			matches:    []string{"derived 2 /a/b/c"},
+		},
+		{
+			expression: "sort(items[*].name)[0]",
+			matches:    []string{`derived "x" /items/0/name /items/1/name /items/2/name`},
+		},
+		{
+			expression: "[a.b.c, items[0].price]",
+			matches:    []string{"derived [1,1] /a/b/c /items/0/price"},
+		},
+		{
+			expression: "let $p = `1` in items[?price == $p].name",
+			matches:    []string{`/items/0/name items[0].name "x"`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			t.Parallel()
+
+			data := decodeTestJSON(t, given)
+			matches, err := MustCompile(test.expression).SearchPaths(data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]string, len(matches))
+			for i, m := range matches {
+				if m.Derived {
+					parts := []string{"derived", encodeTestJSON(t, m.Value)}
+					for _, s := range m.Sources {
+						parts = append(parts, s.Pointer)
+					}
+
+					got[i] = strings.Join(parts, " ")
+				} else {
+					got[i] = m.Pointer + " " + m.Path + " " + encodeTestJSON(t, m.Value)
+
+					expression, err := Compile(m.Path)
+					if err != nil {
+						t.Fatalf("unexpected error compiling path %q: %v", m.Path, err)
+					}
+
+					value, err := expression.Search(data)
+					if err != nil {
+						t.Fatalf("unexpected error searching path %q: %v", m.Path, err)
+					}
+
+					if !reflect.DeepEqual(value, m.Value) {
+						t.Errorf("path %q selects %s, expected %s", m.Path, encodeTestJSON(t, value), encodeTestJSON(t, m.Value))
+					}
+				}
+			}
+
+			if !slices.Equal(got, test.matches) {
+				t.Errorf("expected matches %q, got %q", test.matches, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package jmespath
+
+import (
+	"time"
+
+	"github.com/woodsbury/jmespath/internal/evaluator"
+)
+
+// Option configures how an expression is evaluated. Options are created with
+// functions such as [WithClock].
+type Option struct {
+	apply func(*evaluator.Options)
+}
+
+// WithClock sets the function used by the now() function to obtain the
+// current time. By default, [time.Now] is used.
+func WithClock(clock func() time.Time) Option {
+	return Option{
+		apply: func(options *evaluator.Options) {
+			options.Now = clock
+		},
+	}
+}
+
+func evaluateOptions(opts []Option) evaluator.Options {
+	if len(opts) == 0 {
+		return evaluator.Options{}
+	}
+
+	options := new(evaluator.Options)
+	for _, opt := range opts {
+		if opt.apply != nil {
+			opt.apply(options)
+		}
+	}
+
+	return *options
+}
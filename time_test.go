@@ -0,0 +1,29 @@
+package jmespath
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTimeTypeError(t *testing.T) {
+	t.Parallel()
+
+	for _, expression := range []string{
+		"add_duration(`0`, `true`)",
+		"add_duration(`true`, `0`)",
+		"time_diff(`0`, `true`)",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Search(expression, nil)
+			if !errors.Is(err, ErrInvalidType) {
+				t.Fatalf("expected error %v, got %v", ErrInvalidType, err)
+			}
+
+			if want := "jmespath: invalid type bool when expecting string or number"; err.Error() != want {
+				t.Errorf("expected error message %q, got %q", want, err.Error())
+			}
+		})
+	}
+}
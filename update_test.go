@@ -0,0 +1,493 @@
+package jmespath
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompileAssignable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expression string
+		err        string
+	}{
+		{
+			expression: "items[?type=='x'].price",
+		},
+		{
+			expression: "a.*[0]",
+		},
+		{
+			expression: "length(a)",
+			err:        "jmespath: operation LengthNode does not select an assignable location",
+		},
+		{
+			expression: "a[?b > `1`] | [0]",
+			err:        "jmespath: operation PipeNode does not select an assignable location",
+		},
+		{
+			expression: "a[*].b | c",
+			err:        "jmespath: operation PipeNode does not select an assignable location",
+		},
+		{
+			expression: "x.a[*] | [0]",
+			err:        "jmespath: operation PipeNode does not select an assignable location",
+		},
+		{
+			expression: "x.a[*].b | [0]",
+			err:        "jmespath: operation PipeNode does not select an assignable location",
+		},
+		{
+			expression: "a.b[*] | [0]",
+			err:        "jmespath: operation PipeNode does not select an assignable location",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := CompileAssignable(test.expression)
+			if test.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrNotAssignable) {
+				t.Fatalf("expected error %v, got %v", ErrNotAssignable, err)
+			}
+
+			if err.Error() != test.err {
+				t.Errorf("expected error message %q, got %q", test.err, err.Error())
+			}
+		})
+	}
+
+	if _, err := CompileAssignable("a["); !errors.Is(err, ErrSyntax) {
+		t.Errorf("expected error %v, got %v", ErrSyntax, err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expression string
+		given      string
+		result     string
+		err        error
+	}{
+		{
+			expression: "a",
+			given:      `{"a": 1, "b": 2}`,
+			result:     `{"b": 2}`,
+		},
+		{
+			expression: "a.b",
+			given:      `{"a": {"b": 1, "c": 2}}`,
+			result:     `{"a": {"c": 2}}`,
+		},
+		{
+			expression: "a.b.c",
+			given:      `{"x": 1}`,
+			result:     `{"x": 1}`,
+		},
+		{
+			expression: "a.b",
+			given:      `{"a": null}`,
+			result:     `{"a": null}`,
+		},
+		{
+			expression: "users[*].password",
+			given:      `{"users": [{"name": "a", "password": "x"}, {"name": "b"}, null]}`,
+			result:     `{"users": [{"name": "a"}, {"name": "b"}, null]}`,
+		},
+		{
+			expression: "items[?internal]",
+			given:      `{"items": [{"id": 1, "internal": true}, {"id": 2}, {"id": 3, "internal": false}]}`,
+			result:     `{"items": [{"id": 2}, {"id": 3, "internal": false}]}`,
+		},
+		{
+			expression: "items[?internal].secret",
+			given:      `{"items": [{"internal": true, "secret": 1}, {"secret": 2}]}`,
+			result:     `{"items": [{"internal": true}, {"secret": 2}]}`,
+		},
+		{
+			expression: "a[1]",
+			given:      `{"a": [1, 2, 3]}`,
+			result:     `{"a": [1, 3]}`,
+		},
+		{
+			expression: "a[-1]",
+			given:      `{"a": [1, 2, 3]}`,
+			result:     `{"a": [1, 2]}`,
+		},
+		{
+			expression: "a[3]",
+			given:      `{"a": [1, 2, 3]}`,
+			result:     `{"a": [1, 2, 3]}`,
+		},
+		{
+			expression: "a[::2]",
+			given:      `{"a": [1, 2, 3, 4, 5]}`,
+			result:     `{"a": [2, 4]}`,
+		},
+		{
+			expression: "a.*.b",
+			given:      `{"a": {"x": {"b": 1, "c": 1}, "y": {"b": 2}}}`,
+			result:     `{"a": {"x": {"c": 1}, "y": {}}}`,
+		},
+		{
+			expression: "a.*",
+			given:      `{"a": {"x": 1, "y": 2}}`,
+			result:     `{"a": {}}`,
+		},
+		{
+			expression: "@",
+			given:      `{"a": 1}`,
+			result:     `null`,
+		},
+		{
+			expression: "length(a)",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "a[?b] | [0]",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "x.a[*] | [0]",
+			given:      `{"x": {"a": [[1, 2], [3, 4]]}}`,
+			err:        ErrNotAssignable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Compile(test.expression)
+			if err != nil {
+				t.Fatalf("unexpected error compiling expression: %v", err)
+			}
+
+			given := decodeTestJSON(t, test.given)
+			before := encodeTestJSON(t, given)
+
+			result, err := expression.Delete(given)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Errorf("expected error %v, got %v", test.err, err)
+				}
+
+				if result != nil {
+					t.Errorf("expected no result, got %s", encodeTestJSON(t, result))
+				}
+
+				if after := encodeTestJSON(t, given); after != before {
+					t.Errorf("input modified: %s became %s", before, after)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if after := encodeTestJSON(t, given); after != before {
+				t.Errorf("input modified: %s became %s", before, after)
+			}
+
+			if want := decodeTestJSON(t, test.result); !reflect.DeepEqual(result, want) {
+				t.Errorf("expected result %s, got %s", encodeTestJSON(t, want), encodeTestJSON(t, result))
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expression string
+		given      string
+		value      string
+		result     string
+		err        error
+	}{
+		{
+			expression: "a",
+			given:      `{"a": 1, "b": 2}`,
+			value:      `3`,
+			result:     `{"a": 3, "b": 2}`,
+		},
+		{
+			expression: "a.b.c",
+			given:      `{"a": {"b": {"c": 1, "d": 2}}}`,
+			value:      `"x"`,
+			result:     `{"a": {"b": {"c": "x", "d": 2}}}`,
+		},
+		{
+			expression: "a.b.c",
+			given:      `{}`,
+			value:      `true`,
+			result:     `{"a": {"b": {"c": true}}}`,
+		},
+		{
+			expression: "a.b",
+			given:      `{"a": "string"}`,
+			value:      `1`,
+			result:     `{"a": "string"}`,
+		},
+		{
+			expression: "a[1]",
+			given:      `{"a": [1, 2, 3]}`,
+			value:      `0`,
+			result:     `{"a": [1, 0, 3]}`,
+		},
+		{
+			expression: "a[-1].b",
+			given:      `{"a": [{"b": 1}, {"b": 2}]}`,
+			value:      `0`,
+			result:     `{"a": [{"b": 1}, {"b": 0}]}`,
+		},
+		{
+			expression: "a[5]",
+			given:      `{"a": [1, 2, 3]}`,
+			value:      `0`,
+			result:     `{"a": [1, 2, 3]}`,
+		},
+		{
+			expression: "a[1:3]",
+			given:      `{"a": [1, 2, 3, 4]}`,
+			value:      `0`,
+			result:     `{"a": [1, 0, 0, 4]}`,
+		},
+		{
+			expression: "a[::-2]",
+			given:      `{"a": [1, 2, 3, 4]}`,
+			value:      `0`,
+			result:     `{"a": [1, 0, 3, 0]}`,
+		},
+		{
+			expression: "a[*].b",
+			given:      `{"a": [{"b": 1}, {"c": 2}, {"b": null}, null, 3]}`,
+			value:      `0`,
+			result:     `{"a": [{"b": 0}, {"c": 2}, {"b": null}, null, 3]}`,
+		},
+		{
+			expression: "a[*].b.c",
+			given:      `{"a": [{"b": {"c": 1}}, {"b": {}}, {}]}`,
+			value:      `0`,
+			result:     `{"a": [{"b": {"c": 0}}, {"b": {}}, {}]}`,
+		},
+		{
+			expression: "a[*].b[*].c",
+			given:      `{"a": [{"b": [{"c": 1}, {"c": 2}]}, {"b": [{"c": 3}]}]}`,
+			value:      `0`,
+			result:     `{"a": [{"b": [{"c": 0}, {"c": 0}]}, {"b": [{"c": 0}]}]}`,
+		},
+		{
+			expression: "a[*]",
+			given:      `{"a": [1, null, 2]}`,
+			value:      `0`,
+			result:     `{"a": [0, null, 0]}`,
+		},
+		{
+			expression: "a[].b",
+			given:      `{"a": [[{"b": 1}], {"b": 2}]}`,
+			value:      `0`,
+			result:     `{"a": [[{"b": 0}], {"b": 0}]}`,
+		},
+		{
+			expression: "a[].b",
+			given:      `{"a": [[{"b": 1}, {}], {"c": 2}]}`,
+			value:      `0`,
+			result:     `{"a": [[{"b": 0}, {}], {"c": 2}]}`,
+		},
+		{
+			expression: "x.a[*][]",
+			given:      `{"x": {"a": [[[1], 2], [3]]}}`,
+			value:      `0`,
+			result:     `{"x": {"a": [[0, 0], [0]]}}`,
+		},
+		{
+			expression: "a.*.b",
+			given:      `{"a": {"x": {"b": 1}, "y": {"b": 2}}}`,
+			value:      `0`,
+			result:     `{"a": {"x": {"b": 0}, "y": {"b": 0}}}`,
+		},
+		{
+			expression: "a.*",
+			given:      `{"a": {"x": 1, "y": 2}}`,
+			value:      `0`,
+			result:     `{"a": {"x": 0, "y": 0}}`,
+		},
+		{
+			expression: "items[?type=='x'].price",
+			given:      `{"items": [{"type": "x", "price": 1}, {"type": "y", "price": 2}, {"type": "x", "price": 3}]}`,
+			value:      `0`,
+			result:     `{"items": [{"type": "x", "price": 0}, {"type": "y", "price": 2}, {"type": "x", "price": 0}]}`,
+		},
+		{
+			expression: "items[?type=='x'].price",
+			given:      `{"items": [{"type": "x", "price": 1}, {"type": "x"}]}`,
+			value:      `0`,
+			result:     `{"items": [{"type": "x", "price": 0}, {"type": "x"}]}`,
+		},
+		{
+			expression: "items[?type=='x']",
+			given:      `{"items": [{"type": "x"}, {"type": "y"}]}`,
+			value:      `null`,
+			result:     `{"items": [null, {"type": "y"}]}`,
+		},
+		{
+			expression: "a | b",
+			given:      `{"a": {"b": 1}}`,
+			value:      `2`,
+			result:     `{"a": {"b": 2}}`,
+		},
+		{
+			expression: "let $t = 'x' in items[?type == $t].price",
+			given:      `{"items": [{"type": "x", "price": 1}, {"type": "y", "price": 2}]}`,
+			value:      `0`,
+			result:     `{"items": [{"type": "x", "price": 0}, {"type": "y", "price": 2}]}`,
+		},
+		{
+			expression: "length(a)",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "a + b",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "[a, b]",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "{a: a}",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "a[*] | [0]",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "$.a",
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "x.a[*] | [0]",
+			given:      `{"x": {"a": [[1, 2], [3, 4]]}}`,
+			value:      `"X"`,
+			err:        ErrNotAssignable,
+		},
+		{
+			expression: "x.a[*].b | [0]",
+			given:      `{"x": {"a": [{"b": [1, 2]}, {"b": [3, 4]}]}}`,
+			value:      `"X"`,
+			err:        ErrNotAssignable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			t.Parallel()
+
+			expression, err := Compile(test.expression)
+			if err != nil {
+				t.Fatalf("unexpected error compiling expression: %v", err)
+			}
+
+			given := decodeTestJSON(t, test.given)
+			before := encodeTestJSON(t, given)
+
+			result, err := expression.Set(given, decodeTestJSON(t, test.value))
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Errorf("expected error %v, got %v", test.err, err)
+				}
+
+				if result != nil {
+					t.Errorf("expected no result, got %s", encodeTestJSON(t, result))
+				}
+
+				if after := encodeTestJSON(t, given); after != before {
+					t.Errorf("input modified: %s became %s", before, after)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if after := encodeTestJSON(t, given); after != before {
+				t.Errorf("input modified: %s became %s", before, after)
+			}
+
+			if want := decodeTestJSON(t, test.result); !reflect.DeepEqual(result, want) {
+				t.Errorf("expected result %s, got %s", encodeTestJSON(t, want), encodeTestJSON(t, result))
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	expression := MustCompile("items[*].tags")
+	given := decodeTestJSON(t, `{"items": [{"tags": ["a"]}, {"tags": ["b", "c"]}]}`)
+
+	result, err := expression.Update(given, func(old any) any {
+		tags, _ := old.([]any)
+		return append(tags, "new")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := decodeTestJSON(t, `{"items": [{"tags": ["a", "new"]}, {"tags": ["b", "c", "new"]}]}`)
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("expected result %s, got %s", encodeTestJSON(t, want), encodeTestJSON(t, result))
+	}
+}
+
+func decodeTestJSON(t *testing.T, s string) any {
+	t.Helper()
+
+	if s == "" {
+		return nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		t.Fatalf("error decoding %s: %v", s, err)
+	}
+
+	return v
+}
+
+func encodeTestJSON(t *testing.T, v any) string {
+	t.Helper()
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("error encoding value: %v", err)
+	}
+
+	return string(b)
+}